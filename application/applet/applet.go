@@ -26,6 +26,7 @@ func main() {
 	defer server.Stop()
 
 	ctx := svc.NewServiceContext(c)
+	server.Use(ctx.ClientInfo)
 	handler.RegisterHandlers(server, ctx)
 
 	// 自定义错误处理方法
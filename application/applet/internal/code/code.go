@@ -3,9 +3,34 @@ package code
 import "myBeyond/pkg/xcode"
 
 var (
-	RegisterMobileEmpty   = xcode.New(10001, "注册手机号不能为空")
-	VerificationCodeEmpty = xcode.New(100002, "验证码不能为空")
-	MobileHasRegistered   = xcode.New(100003, "手机号已经注册")
-	LoginMobileEmpty      = xcode.New(100003, "手机号不能为空")
-	VerificationCodeError = xcode.New(100004, "验证码错误")
+	RegisterMobileEmpty     = xcode.New(10001, "注册手机号不能为空")
+	VerificationCodeEmpty   = xcode.New(100002, "验证码不能为空")
+	MobileHasRegistered     = xcode.New(100003, "手机号已经注册")
+	LoginMobileEmpty        = xcode.New(100003, "手机号不能为空")
+	VerificationCodeError   = xcode.New(100004, "验证码错误")
+	RefreshTokenEmpty       = xcode.New(100005, "refresh token不能为空")
+	RefreshTokenInvalid     = xcode.New(100006, "refresh token无效")
+	RefreshTooEarly         = xcode.New(100007, "还未到刷新token的时间")
+	LoginPasswordEmpty      = xcode.New(100008, "密码不能为空")
+	VerificationCodeExpired = xcode.New(100009, "验证码已过期")
+	VerificationCodeLocked  = xcode.New(100010, "验证码错误次数过多，请稍后再试")
+	VerificationTooFrequent = xcode.New(100011, "验证码发送过于频繁，请稍后再试")
+	VerificationMobileLimit = xcode.New(100012, "该手机号验证码发送次数过多，请稍后再试")
+	VerificationIpLimit     = xcode.New(100013, "当前IP验证码发送次数过多，请稍后再试")
+	VerificationGlobalLimit = xcode.New(100014, "验证码服务繁忙，请稍后再试")
+	VerificationDailyLimit  = xcode.New(100015, "今日验证码发送次数已达上限")
+	AvatarEmpty             = xcode.New(100016, "头像文件不能为空")
+	AvatarTooLarge          = xcode.New(100017, "头像文件过大")
+	AvatarTypeInvalid       = xcode.New(100018, "头像只支持jpeg和png格式")
+	AvatarUploadFailed      = xcode.New(100019, "头像上传失败")
+	MobileNotMatch          = xcode.New(100020, "手机号与当前账号不一致")
+	ChangeMobileTicketError = xcode.New(100021, "原手机号验证已失效，请重新验证")
+	WechatCodeEmpty         = xcode.New(100022, "微信登录code不能为空")
+	WechatLoginFailed       = xcode.New(100023, "微信登录失败")
+	WechatBindTicketInvalid = xcode.New(100024, "微信登录已失效，请重新登录")
+	DeactivateVerifyEmpty   = xcode.New(100025, "注销账号需要验证码或密码")
+	SessionNotFound         = xcode.New(100026, "登录设备不存在或已下线")
+	LoginEmailEmpty         = xcode.New(100027, "邮箱不能为空")
+	PasswordLoginIpLimit    = xcode.New(100028, "当前IP登录次数过多，请稍后再试")
+	EmailNotMatch           = xcode.New(100029, "邮箱与当前账号不一致")
 )
@@ -1,6 +1,10 @@
 package config
 
 import (
+	"myBeyond/application/applet/internal/wechat"
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/signature"
+
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -9,12 +13,56 @@ import (
 type Config struct {
 	rest.RestConf
 	Auth struct {
-		AccessSecret  string
+		AccessSecret string
+		// 签名密钥，第一个用于签发，其余的只用于校验，支持HS256、RS256和EdDSA
+		// 为空时使用AccessSecret签发和校验；不为空时AccessSecret只用于校验没有kid的旧token
+		AccessKeys    []jwt.Key `json:",optional"`
 		AccessExpire  int64
 		RefreshSecret string
 		RefreshExpire int64
 		RefreshAfter  int64
 	}
-	UserRPC  zrpc.RpcClientConf
-	BizRedis redis.RedisConf
+	// /v1/user下接口的HMAC请求签名，调用方的app key在Keys中登记
+	RequestSign signature.Conf
+	UserRPC     zrpc.RpcClientConf
+	BizRedis    redis.RedisConf
+	Oss         struct {
+		Endpoint         string
+		AccessKeyId      string
+		AccessKeySecret  string
+		BucketName       string
+		ConnectTimeout   int64 `json:",optional"`
+		ReadWriteTimeout int64 `json:",optional"`
+	}
+	// 多设备登录，MaxSessions为0表示不限制同时在线的设备数
+	Session struct {
+		MaxSessions int `json:",default=5"`
+	} `json:",optional"`
+	// 小程序登录，AppId为空时不开启微信登录
+	Wechat wechat.Config `json:",optional"`
+	// 邮箱验证码，LoginLink不为空时邮件中附带打开客户端的链接，链接上带有email和code参数
+	Email struct {
+		VerificationTemplate string `json:",default=email_verification"`
+		LoginLink            string `json:",optional"`
+	} `json:",optional"`
+	Sms struct {
+		VerificationTemplate string `json:",default=verification"` // 验证码短信模板
+	} `json:",optional"`
+	// 反向代理的IP或网段，只有请求来自这些地址时才从X-Forwarded-For中取客户端IP
+	TrustedProxies []string `json:",optional"`
+	// 验证码发送限流，窗口单位为秒，次数为0表示不限制
+	VerificationLimit struct {
+		Interval     int `json:",default=60"` // 同一手机号最小发送间隔
+		MobileWindow int `json:",default=3600"`
+		MobileQuota  int `json:",default=5"`
+		IpWindow     int `json:",default=3600"`
+		IpQuota      int `json:",default=20"`
+		GlobalWindow int `json:",default=60"`
+		GlobalQuota  int `json:",default=500"`
+	} `json:",optional"`
+	// 密码登录按客户端IP限流，窗口单位为秒，次数为0表示不限制
+	PasswordLoginLimit struct {
+		IpWindow int `json:",default=3600"`
+		IpQuota  int `json:",default=30"`
+	} `json:",optional"`
 }
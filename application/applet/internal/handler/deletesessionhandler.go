@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"myBeyond/application/applet/internal/logic"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func DeleteSessionHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DeleteSessionRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewDeleteSessionLogic(r.Context(), svcCtx)
+		resp, err := l.DeleteSession(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
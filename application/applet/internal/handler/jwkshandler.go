@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"net/http"
+
+	"myBeyond/application/applet/internal/logic"
+	"myBeyond/application/applet/internal/svc"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 公钥变化不频繁，允许网关缓存一段时间
+const jwksCacheControl = "public, max-age=300"
+
+func JwksHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := logic.NewJwksLogic(r.Context(), svcCtx)
+		resp, err := l.Jwks()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			w.Header().Set("Cache-Control", jwksCacheControl)
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -27,20 +27,111 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/login",
 				Handler: LoginHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/login/password",
+				Handler: LoginByPasswordHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/login/wechat",
+				Handler: WechatLoginHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/login/wechat/bind",
+				Handler: WechatBindHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/verification/email",
+				Handler: EmailVerificationHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/login/email",
+				Handler: EmailLoginHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/refresh",
+				Handler: RefreshHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/jwks",
+				Handler: JwksHandler(serverCtx),
+			},
 		},
 		rest.WithPrefix("/v1"),
 	)
 
 	server.AddRoutes(
-		[]rest.Route{
-			{
-				Method:  http.MethodGet,
-				Path:    "/info",
-				Handler: UserInfoHandler(serverCtx),
-			},
-		},
-		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
-		rest.WithSignature(serverCtx.Config.Signature),
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Signature, serverCtx.JwtAuth, serverCtx.TokenRevoke, serverCtx.Session},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/info",
+					Handler: UserInfoHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/info",
+					Handler: UpdateUserInfoHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/logout",
+					Handler: LogoutHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/mobile/verify",
+					Handler: VerifyOldMobileHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/mobile",
+					Handler: ChangeMobileHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/deactivate",
+					Handler: DeactivateHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/email",
+					Handler: BindEmailHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/sessions",
+					Handler: SessionsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/sessions/:id",
+					Handler: DeleteSessionHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/v1/user"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Signature, serverCtx.JwtAuth, serverCtx.TokenRevoke, serverCtx.Session},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/avatar",
+					Handler: UploadAvatarHandler(serverCtx),
+				},
+			}...,
+		),
 		rest.WithPrefix("/v1/user"),
+		rest.WithMaxBytes(3145728),
 	)
 }
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"myBeyond/application/applet/internal/logic"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/pkg/xcode"
+	xcodetypes "myBeyond/pkg/xcode/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 解析表单时保存在内存中的上限，超过的部分写入临时文件
+const avatarFormMaxMemory = 2 << 20
+
+func UploadAvatarHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 不是合法的multipart表单时返回400
+		if err := r.ParseMultipartForm(avatarFormMaxMemory); err != nil {
+			httpx.WriteJsonCtx(r.Context(), w, http.StatusBadRequest, xcodetypes.Status{
+				Code:    int32(xcode.RequestErr.Code()),
+				Message: xcode.RequestErr.Message(),
+			})
+			return
+		}
+
+		l := logic.NewUploadAvatarLogic(r.Context(), svcCtx)
+		resp, err := l.UploadAvatar(r)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
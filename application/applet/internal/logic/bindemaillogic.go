@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BindEmailLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBindEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BindEmailLogic {
+	return &BindEmailLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// BindEmail 绑定或修改邮箱，之后可以使用邮箱验证码登录
+func (l *BindEmailLogic) BindEmail(req *types.BindEmailRequest) (resp *types.BindEmailResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		return nil, err
+	}
+
+	// 1、检查参数
+	email := normalizeEmail(req.Email)
+	if len(email) == 0 {
+		return nil, code.LoginEmailEmpty
+	}
+	req.VerificationCode = strings.TrimSpace(req.VerificationCode)
+	if len(req.VerificationCode) == 0 {
+		return nil, code.VerificationCodeEmpty
+	}
+
+	// 2、校验新邮箱的验证码
+	err = checkVerificationCode(l.ctx, l.svcCtx.BizRedis, email, req.VerificationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3、绑定邮箱，邮箱已被使用时由用户服务返回错误
+	_, err = l.svcCtx.UserRPC.BindEmail(l.ctx, &user.BindEmailRequest{
+		UserId: userId,
+		Email:  email,
+	})
+	if err != nil {
+		logx.Errorf("BindEmail userId: %d error: %v", userId, err)
+		return nil, err
+	}
+	delActivationCache(email, req.VerificationCode, l.svcCtx.BizRedis)
+
+	return &types.BindEmailResponse{}, nil
+}
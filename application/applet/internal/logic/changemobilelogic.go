@@ -0,0 +1,144 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+type ChangeMobileLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewChangeMobileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChangeMobileLogic {
+	return &ChangeMobileLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ChangeMobileLogic) ChangeMobile(req *types.ChangeMobileRequest) (resp *types.ChangeMobileResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		return nil, err
+	}
+
+	// 1、检查参数
+	req.Mobile = strings.TrimSpace(req.Mobile)
+	if len(req.Mobile) == 0 {
+		return nil, code.LoginMobileEmpty
+	}
+	req.VerificationCode = strings.TrimSpace(req.VerificationCode)
+	if len(req.VerificationCode) == 0 {
+		return nil, code.VerificationCodeEmpty
+	}
+
+	// 2、原手机号必须已经验证通过
+	ticketKey := fmt.Sprintf(prefixChangeMobileTicket, strings.TrimSpace(req.Ticket))
+	val, err := l.svcCtx.BizRedis.GetCtx(l.ctx, ticketKey)
+	if err != nil {
+		logx.Errorf("GetCtx key: %s error: %v", ticketKey, err)
+		return nil, err
+	}
+	if val != strconv.FormatInt(userId, 10) {
+		return nil, code.ChangeMobileTicketError
+	}
+
+	// 3、校验新手机号的验证码，新手机号不能已经注册
+	err = checkVerificationCode(l.ctx, l.svcCtx.BizRedis, req.Mobile, req.VerificationCode)
+	if err != nil {
+		return nil, err
+	}
+	u, err := l.svcCtx.UserRPC.FindByMobile(l.ctx, &user.FindByMobileRequest{Mobile: req.Mobile})
+	if err != nil {
+		logx.Errorf("FindByMobile error: %v", err)
+		return nil, err
+	}
+	if u != nil && u.UserId > 0 {
+		return nil, code.MobileHasRegistered
+	}
+
+	// 4、原子地取出并删除凭证，并发的请求只有一个能继续修改手机号
+	val, err = consumeTicket(l.ctx, l.svcCtx.BizRedis, ticketKey)
+	if err != nil {
+		logx.Errorf("consumeTicket key: %s error: %v", ticketKey, err)
+		return nil, err
+	}
+	if val != strconv.FormatInt(userId, 10) {
+		return nil, code.ChangeMobileTicketError
+	}
+
+	// 5、修改手机号
+	_, err = l.svcCtx.UserRPC.ChangeMobile(l.ctx, &user.ChangeMobileRequest{
+		UserId: userId,
+		Mobile: req.Mobile,
+	})
+	if err != nil {
+		logx.Errorf("ChangeMobile userId: %d error: %v", userId, err)
+		return nil, err
+	}
+	delActivationCache(req.Mobile, req.VerificationCode, l.svcCtx.BizRedis)
+
+	// 6、吊销之前签发的所有token，当前设备重新登录
+	err = revokeUserTokens(l.ctx, l.svcCtx, userId)
+	if err != nil {
+		logx.Errorf("RevokeUser userId: %d error: %v", userId, err)
+		return nil, err
+	}
+	token, err := sessionTokens(l.ctx, l.svcCtx, userId)
+	if err != nil {
+		logx.Errorf("BuildTokens error: %v", err)
+		return nil, err
+	}
+
+	return &types.ChangeMobileResponse{Token: token}, nil
+}
+
+// 取出凭证的同时删除，凭证只能使用一次
+var consumeTicketScript = redis.NewScript(`local val = redis.call("GET", KEYS[1])
+if val then
+    redis.call("DEL", KEYS[1])
+end
+return val`)
+
+// consumeTicket 凭证不存在时返回空字符串
+func consumeTicket(ctx context.Context, rds *redis.Redis, key string) (string, error) {
+	resp, err := rds.ScriptRunCtx(ctx, consumeTicketScript, []string{key})
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	val, _ := resp.(string)
+
+	return val, nil
+}
+
+// 吊销用户在此之前签发的所有token，有效期覆盖access token和refresh token，同时删除所有会话
+func revokeUserTokens(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) error {
+	auth := svcCtx.Config.Auth
+	ttl := auth.AccessExpire
+	if auth.RefreshExpire > ttl {
+		ttl = auth.RefreshExpire
+	}
+
+	uid := strconv.FormatInt(userId, 10)
+	if err := svcCtx.RevokeStore.RevokeUser(ctx, uid, ttl); err != nil {
+		return err
+	}
+	return svcCtx.SessionStore.DeleteAll(ctx, uid)
+}
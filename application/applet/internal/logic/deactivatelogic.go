@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeactivateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeactivateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeactivateLogic {
+	return &DeactivateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeactivateLogic) Deactivate(req *types.DeactivateRequest) (resp *types.DeactivateResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		return nil, err
+	}
+
+	// 1、重新验证身份，密码由用户服务校验，验证码在这里校验
+	req.Password = strings.TrimSpace(req.Password)
+	if len(req.Password) == 0 {
+		if err = l.checkCode(userId, req); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2、申请注销，进入冷静期
+	ret, err := l.svcCtx.UserRPC.DeactivateAccount(l.ctx, &user.DeactivateAccountRequest{
+		UserId:   userId,
+		Password: req.Password,
+	})
+	if err != nil {
+		logx.Errorf("DeactivateAccount userId: %d error: %v", userId, err)
+		return nil, err
+	}
+
+	// 3、退出所有设备，重新登录会取消注销
+	err = revokeUserTokens(l.ctx, l.svcCtx, userId)
+	if err != nil {
+		logx.Errorf("RevokeUser userId: %d error: %v", userId, err)
+		return nil, err
+	}
+
+	return &types.DeactivateResponse{DeleteTime: ret.DeleteTime}, nil
+}
+
+// 校验当前手机号或邮箱的验证码，手机号或邮箱必须属于当前用户，只绑定了邮箱的用户使用邮箱
+func (l *DeactivateLogic) checkCode(userId int64, req *types.DeactivateRequest) error {
+	req.Mobile = strings.TrimSpace(req.Mobile)
+	email := normalizeEmail(req.Email)
+	req.VerificationCode = strings.TrimSpace(req.VerificationCode)
+	if (len(req.Mobile) == 0 && len(email) == 0) || len(req.VerificationCode) == 0 {
+		return code.DeactivateVerifyEmpty
+	}
+
+	// 验证码按手机号或邮箱保存
+	key := req.Mobile
+	if len(key) > 0 {
+		u, err := l.svcCtx.UserRPC.FindByMobile(l.ctx, &user.FindByMobileRequest{Mobile: req.Mobile})
+		if err != nil {
+			logx.Errorf("FindByMobile error: %v", err)
+			return err
+		}
+		if u == nil || u.UserId != userId {
+			return code.MobileNotMatch
+		}
+	} else {
+		key = email
+		u, err := l.svcCtx.UserRPC.FindByEmail(l.ctx, &user.FindByEmailRequest{Email: email})
+		if err != nil {
+			logx.Errorf("FindByEmail email: %s error: %v", email, err)
+			return err
+		}
+		if u == nil || u.UserId != userId {
+			return code.EmailNotMatch
+		}
+	}
+
+	if err := checkVerificationCode(l.ctx, l.svcCtx.BizRedis, key, req.VerificationCode); err != nil {
+		return err
+	}
+	delActivationCache(key, req.VerificationCode, l.svcCtx.BizRedis)
+
+	return nil
+}
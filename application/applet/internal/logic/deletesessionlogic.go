@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteSessionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeleteSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteSessionLogic {
+	return &DeleteSessionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// DeleteSession 下线指定设备，该会话签发的access token和refresh token随之失效
+func (l *DeleteSessionLogic) DeleteSession(req *types.DeleteSessionRequest) (resp *types.DeleteSessionResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		return nil, err
+	}
+
+	req.Id = strings.TrimSpace(req.Id)
+	if len(req.Id) == 0 {
+		return nil, code.SessionNotFound
+	}
+	ok, err := l.svcCtx.SessionStore.Delete(l.ctx, strconv.FormatInt(userId, 10), req.Id)
+	if err != nil {
+		logx.Errorf("Delete session userId: %d sessionId: %s error: %v", userId, req.Id, err)
+		return nil, err
+	}
+	if !ok {
+		return nil, code.SessionNotFound
+	}
+
+	return &types.DeleteSessionResponse{}, nil
+}
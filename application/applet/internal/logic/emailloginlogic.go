@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 邮箱注册没有填写用户名时的默认用户名
+const defaultEmailUsername = "邮箱用户"
+
+type EmailLoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewEmailLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EmailLoginLogic {
+	return &EmailLoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// EmailLogin 邮箱验证码登录，邮箱未注册时自动注册，用于没有国内手机号的用户
+func (l *EmailLoginLogic) EmailLogin(req *types.EmailLoginRequest) (resp *types.LoginResponse, err error) {
+	// 1、检查参数
+	email := normalizeEmail(req.Email)
+	if len(email) == 0 {
+		return nil, code.LoginEmailEmpty
+	}
+	req.VerificationCode = strings.TrimSpace(req.VerificationCode)
+	if len(req.VerificationCode) == 0 {
+		return nil, code.VerificationCodeEmpty
+	}
+
+	// 2、校验邮箱验证码
+	err = checkVerificationCode(l.ctx, l.svcCtx.BizRedis, email, req.VerificationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3、查找邮箱对应的用户，不存在时注册
+	u, err := l.svcCtx.UserRPC.FindByEmail(l.ctx, &user.FindByEmailRequest{Email: email})
+	if err != nil {
+		logx.Errorf("FindByEmail email: %s error: %v", email, err)
+		return nil, err
+	}
+	userId := u.UserId
+	if userId == 0 {
+		name := strings.TrimSpace(req.Name)
+		if len(name) == 0 {
+			name = defaultEmailUsername
+		}
+		regRet, err := l.svcCtx.UserRPC.Register(l.ctx, &user.RegisterRequest{
+			Username: name,
+			Email:    email,
+		})
+		if err != nil {
+			logx.Errorf("Register email: %s error: %v", email, err)
+			return nil, err
+		}
+		userId = regRet.UserId
+	}
+
+	// 4、生成token，删除验证码缓存
+	token, err := loginTokens(l.ctx, l.svcCtx, userId)
+	if err != nil {
+		logx.Errorf("BuildTokens error: %v", err)
+		return nil, err
+	}
+	delActivationCache(email, req.VerificationCode, l.svcCtx.BizRedis)
+
+	return &types.LoginResponse{
+		UserId: userId,
+		Token:  token,
+	}, nil
+}
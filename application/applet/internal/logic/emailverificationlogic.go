@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EmailVerificationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewEmailVerificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EmailVerificationLogic {
+	return &EmailVerificationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// EmailVerification 发送邮箱验证码，限流规则与短信验证码相同
+func (l *EmailVerificationLogic) EmailVerification(req *types.EmailVerificationRequest) (resp *types.VerificationResponse, err error) {
+	email := normalizeEmail(req.Email)
+	if len(email) == 0 {
+		return nil, code.LoginEmailEmpty
+	}
+
+	c := l.svcCtx.Config.Email
+	err = NewVerificationLogic(l.ctx, l.svcCtx).sendCode(email, func(verificationCode string) error {
+		params := map[string]string{"code": verificationCode}
+		if len(c.LoginLink) > 0 {
+			params["link"] = emailLoginLink(c.LoginLink, email, verificationCode)
+		}
+		_, err := l.svcCtx.UserRPC.SendEmail(l.ctx, &user.SendEmailRequest{
+			Email:      email,
+			TemplateId: c.VerificationTemplate,
+			Params:     params,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VerificationResponse{}, nil
+}
+
+// 邮箱统一转为小写，验证码按处理后的邮箱保存，格式由用户服务校验
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// 在链接上追加email和code参数
+func emailLoginLink(link, email, verificationCode string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	query := u.Query()
+	query.Set("email", email)
+	query.Set("code", verificationCode)
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
@@ -0,0 +1,185 @@
+package logic
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// fakeRedis 只实现验证码、绑定凭证和退出登录用到的命令，脚本按内容识别，不处理过期
+type fakeRedis struct {
+	mu     sync.Mutex
+	values map[string]string
+	hashes map[string]map[string]string
+}
+
+func newTestRedis(t *testing.T) (*redis.Redis, *fakeRedis) {
+	rds := &fakeRedis{values: make(map[string]string), hashes: make(map[string]map[string]string)}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go rds.serve(conn)
+		}
+	}()
+
+	return redis.New(ln.Addr().String()), rds
+}
+
+func (r *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		io.WriteString(conn, r.handle(args))
+	}
+}
+
+func (r *fakeRedis) handle(args []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "GET":
+		v, ok := r.values[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+	case "SET":
+		r.values[args[1]] = args[2]
+		return "+OK\r\n"
+	case "SETEX":
+		r.values[args[1]] = args[3]
+		return "+OK\r\n"
+	case "DEL":
+		var removed int
+		for _, key := range args[1:] {
+			if _, ok := r.values[key]; ok {
+				delete(r.values, key)
+				removed++
+			}
+		}
+		return fmt.Sprintf(":%d\r\n", removed)
+	case "HDEL":
+		var removed int
+		for _, field := range args[2:] {
+			if _, ok := r.hashes[args[1]][field]; ok {
+				delete(r.hashes[args[1]], field)
+				removed++
+			}
+		}
+		return fmt.Sprintf(":%d\r\n", removed)
+	case "EVALSHA":
+		// 让客户端改用EVAL发送脚本内容
+		return "-NOSCRIPT No matching script\r\n"
+	case "EVAL":
+		numKeys, _ := strconv.Atoi(args[2])
+		return r.eval(args[1], args[3:3+numKeys], args[3+numKeys:])
+	}
+	return "-ERR unknown command '" + args[0] + "'\r\n"
+}
+
+func (r *fakeRedis) eval(script string, keys, argv []string) string {
+	// limit.FailLimit的计数脚本：KEYS[1]为次数，KEYS[2]为锁定，ARGV[2]为允许的次数
+	if strings.Contains(script, "INCR") && len(keys) == 2 {
+		if _, ok := r.values[keys[1]]; ok {
+			return ":-1\r\n"
+		}
+		count, _ := strconv.Atoi(r.values[keys[0]])
+		count++
+		quota, _ := strconv.Atoi(argv[1])
+		if count > quota {
+			r.values[keys[1]] = "1"
+			delete(r.values, keys[0])
+			return ":-1\r\n"
+		}
+		r.values[keys[0]] = strconv.Itoa(count)
+		return fmt.Sprintf(":%d\r\n", count)
+	}
+	// consumeTicketScript：取出凭证并删除
+	if strings.Contains(script, `redis.call("GET"`) && len(keys) == 1 {
+		v, ok := r.values[keys[0]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		delete(r.values, keys[0])
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+	}
+	return "-ERR unknown script\r\n"
+}
+
+func (r *fakeRedis) get(key string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.values[key]
+	return v, ok
+}
+
+func (r *fakeRedis) set(key, value string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[key] = value
+}
+
+func (r *fakeRedis) hset(key, field, value string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.hashes[key]; !ok {
+		r.hashes[key] = make(map[string]string)
+	}
+	r.hashes[key][field] = value
+}
+
+func (r *fakeRedis) hget(key, field string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.hashes[key][field]
+	return v, ok
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		size, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		length, err := strconv.Atoi(strings.TrimSpace(size[1:]))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, length+2)
+		if _, err = io.ReadFull(reader, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:length])
+	}
+	return args, nil
+}
@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type JwksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewJwksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *JwksLogic {
+	return &JwksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Jwks 导出校验access token的公钥，只使用AccessSecret时返回空列表
+func (l *JwksLogic) Jwks() (resp *types.JwksResponse, err error) {
+	set := l.svcCtx.AccessKeys.JWKS()
+	resp = &types.JwksResponse{Keys: make([]types.Jwk, 0, len(set.Keys))}
+	for _, k := range set.Keys {
+		resp.Keys = append(resp.Keys, types.Jwk{
+			Kty: k.Kty,
+			Use: k.Use,
+			Alg: k.Alg,
+			Kid: k.Kid,
+			N:   k.N,
+			E:   k.E,
+			Crv: k.Crv,
+			X:   k.X,
+		})
+	}
+
+	return resp, nil
+}
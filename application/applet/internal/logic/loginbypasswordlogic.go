@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+	"myBeyond/pkg/util"
+	"myBeyond/pkg/xcode"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LoginByPasswordLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewLoginByPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginByPasswordLogic {
+	return &LoginByPasswordLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LoginByPasswordLogic) LoginByPassword(req *types.LoginByPasswordRequest) (*types.LoginResponse, error) {
+	// 1、获取手机号和密码
+	req.Mobile = strings.TrimSpace(req.Mobile)
+	if len(req.Mobile) == 0 {
+		return nil, code.LoginMobileEmpty
+	}
+	req.Password = strings.TrimSpace(req.Password)
+	if len(req.Password) == 0 {
+		return nil, code.LoginPasswordEmpty
+	}
+
+	// 2、按客户端IP限流，错误次数由用户服务按手机号限制
+	clientIp := util.ClientInfoFromContext(l.ctx).Ip
+	ok, wait, err := l.svcCtx.PasswordLoginIpLimit.TakeCtx(l.ctx, clientIp)
+	if err != nil {
+		logx.Errorf("PasswordLoginIpLimit TakeCtx ip: %s error: %v", clientIp, err)
+		return nil, err
+	}
+	if !ok {
+		return nil, xcode.Errorf(code.PasswordLoginIpLimit, "当前IP登录次数过多，请%d秒后重试", int64(math.Ceil(wait.Seconds())))
+	}
+
+	// 3、由用户服务校验手机号和密码
+	u, err := l.svcCtx.UserRPC.LoginByPassword(l.ctx, &user.LoginByPasswordRequest{
+		Mobile:   req.Mobile,
+		Password: req.Password,
+	})
+	if err != nil {
+		logx.Errorf("LoginByPassword mobile: %s error: %v", req.Mobile, err)
+		return nil, err
+	}
+
+	// 4、生成token
+	token, err := loginTokens(l.ctx, l.svcCtx, u.UserId)
+	if err != nil {
+		logx.Errorf("BuildTokens error: %v", err)
+		return nil, err
+	}
+
+	return &types.LoginResponse{
+		UserId: u.UserId,
+		Token:  token,
+	}, nil
+}
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,8 @@ import (
 	"myBeyond/application/applet/internal/types"
 	"myBeyond/application/user/rpc/user"
 	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/limit"
+	"myBeyond/pkg/session"
 	"myBeyond/pkg/xcode"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -41,15 +44,11 @@ func (l *LoginLogic) Login(req *types.LoginRequest) (*types.LoginResponse, error
 		return nil, code.VerificationCodeEmpty
 	}
 	fmt.Println(req.Mobile + " " + req.VerificationCode)
-	//2、判断验证码是否正确
-	isLogin, err := checkVerificationCode(l.svcCtx.BizRedis, req.Mobile, req.VerificationCode)
+	//2、判断验证码是否正确，不正确直接返回
+	err := checkVerificationCode(l.ctx, l.svcCtx.BizRedis, req.Mobile, req.VerificationCode)
 	if err != nil {
 		return nil, err
 	}
-	//2.1、不正确直接返回
-	if !isLogin {
-		return nil, code.VerificationCodeError
-	}
 	//3.查找手机号收否存在
 	mobile := req.Mobile
 	fmt.Println("mobile:" + mobile)
@@ -64,13 +63,7 @@ func (l *LoginLogic) Login(req *types.LoginRequest) (*types.LoginResponse, error
 
 	//4.生成token
 	fmt.Println("token:")
-	token, err := jwt.BuildTokens(jwt.TokenOptions{
-		AccessSecret: l.svcCtx.Config.Auth.AccessSecret,
-		AccessExpire: l.svcCtx.Config.Auth.AccessExpire,
-		Fields: map[string]interface{}{
-			"userId": u.UserId,
-		},
-	})
+	token, err := loginTokens(l.ctx, l.svcCtx, u.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -78,23 +71,86 @@ func (l *LoginLogic) Login(req *types.LoginRequest) (*types.LoginResponse, error
 	delActivationCache(req.Mobile, req.VerificationCode, l.svcCtx.BizRedis)
 	return &types.LoginResponse{
 		UserId: u.UserId,
-		Token: types.Token{
-			AccessToken:  token.AccessToken,
-			AccessExpire: token.AccessExpire,
+		Token:  token,
+	}, nil
+}
+
+// 登录成功后签发token，冷静期内登录会取消账号注销
+func loginTokens(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) (types.Token, error) {
+	ret, err := svcCtx.UserRPC.CancelDeactivation(ctx, &user.CancelDeactivationRequest{UserId: userId})
+	if err != nil {
+		logx.Errorf("CancelDeactivation userId: %d error: %v", userId, err)
+		return types.Token{}, err
+	}
+	if ret.Canceled {
+		logx.Infof("userId: %d deactivation canceled by login", userId)
+	}
+
+	return sessionTokens(ctx, svcCtx, userId)
+}
+
+// 为用户签发access token和refresh token，sessionId写入token用于按设备下线
+func buildTokens(svcCtx *svc.ServiceContext, userId int64, sessionId string) (types.Token, error) {
+	c := svcCtx.Config
+	token, err := jwt.BuildTokens(jwt.TokenOptions{
+		AccessSecret:  c.Auth.AccessSecret,
+		AccessKeys:    svcCtx.AccessKeys,
+		AccessExpire:  c.Auth.AccessExpire,
+		RefreshSecret: c.Auth.RefreshSecret,
+		RefreshExpire: c.Auth.RefreshExpire,
+		RefreshAfter:  c.Auth.RefreshAfter,
+		Fields: map[string]interface{}{
+			types.UserIdKey:  userId,
+			session.ClaimKey: sessionId,
 		},
+	})
+	if err != nil {
+		return types.Token{}, err
+	}
+
+	return types.Token{
+		AccessToken:   token.AccessToken,
+		AccessExpire:  token.AccessExpire,
+		RefreshToken:  token.RefreshToken,
+		RefreshExpire: token.RefreshExpire,
+		RefreshAfter:  token.RefreshAfter,
 	}, nil
 }
 
-func checkVerificationCode(rds *redis.Redis, mobile string, verificationCode string) (bool, error) {
-	key := fmt.Sprintf(prefixActivation, mobile)
-	code, err := rds.Get(key)
+// 验证码的尝试次数限制，连续错误超过maxVerificationFail次后验证码失效，并且锁定一段时间
+func verificationFailLimit(rds *redis.Redis) *limit.FailLimit {
+	return limit.NewFailLimit(expireActivation, maxVerificationFail, expireVerificationLock, rds, prefixVerificationFailLimit)
+}
+
+// 校验验证码，比较之前先计数，并发的请求也不能超过允许的次数
+func checkVerificationCode(ctx context.Context, rds *redis.Redis, mobile string, verificationCode string) error {
+	// 1、占用一次尝试的机会，已经锁定或者次数用完时作废验证码
+	failLimit := verificationFailLimit(rds)
+	ok, err := failLimit.TakeCtx(ctx, mobile)
 	if err != nil {
-		return false, err
+		return err
+	}
+	if !ok {
+		if err = delActivationCache(mobile, verificationCode, rds); err != nil {
+			logx.Errorf("delActivationCache mobile: %s error: %v", mobile, err)
+		}
+		return code.VerificationCodeLocked
+	}
+
+	// 2、比较验证码，正确时清零尝试次数
+	cacheCode, err := getActivationCache(mobile, rds)
+	if err != nil {
+		return err
+	}
+	if len(cacheCode) == 0 {
+		return code.VerificationCodeExpired
+	}
+	if subtle.ConstantTimeCompare([]byte(cacheCode), []byte(verificationCode)) != 1 {
+		return code.VerificationCodeError
 	}
-	if code != verificationCode {
-		return false, nil
-	} else {
-		return true, nil
+	if err = failLimit.ResetCtx(ctx, mobile); err != nil {
+		logx.Errorf("ResetCtx mobile: %s error: %v", mobile, err)
 	}
 
+	return nil
 }
@@ -0,0 +1,194 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/session"
+	"myBeyond/pkg/xcode"
+
+	"google.golang.org/grpc"
+)
+
+type fakeUserRPC struct {
+	user.User
+	cancelErr   error
+	deactivated []int64
+	mobileOwner int64
+	emailOwner  int64
+}
+
+func (f *fakeUserRPC) CancelDeactivation(ctx context.Context, in *user.CancelDeactivationRequest, opts ...grpc.CallOption) (*user.CancelDeactivationResponse, error) {
+	if f.cancelErr != nil {
+		return nil, f.cancelErr
+	}
+	return &user.CancelDeactivationResponse{Canceled: true}, nil
+}
+
+// 冷静期结束后正在清理的账号不能再登录
+func TestLoginTokensAccountDeleting(t *testing.T) {
+	accountDeleting := xcode.New(20016, "账号正在注销")
+	svcCtx := &svc.ServiceContext{UserRPC: &fakeUserRPC{cancelErr: accountDeleting}}
+
+	token, err := loginTokens(context.Background(), svcCtx, 10)
+	if err != accountDeleting {
+		t.Fatalf("err = %v, want %v", err, accountDeleting)
+	}
+	if len(token.AccessToken) > 0 || len(token.RefreshToken) > 0 {
+		t.Errorf("token issued: %+v", token)
+	}
+}
+
+func (f *fakeUserRPC) FindByMobile(ctx context.Context, in *user.FindByMobileRequest, opts ...grpc.CallOption) (*user.FindByMobileResponse, error) {
+	return &user.FindByMobileResponse{UserId: f.mobileOwner}, nil
+}
+
+func (f *fakeUserRPC) FindByEmail(ctx context.Context, in *user.FindByEmailRequest, opts ...grpc.CallOption) (*user.FindByEmailResponse, error) {
+	return &user.FindByEmailResponse{UserId: f.emailOwner}, nil
+}
+
+func (f *fakeUserRPC) DeactivateAccount(ctx context.Context, in *user.DeactivateAccountRequest, opts ...grpc.CallOption) (*user.DeactivateAccountResponse, error) {
+	f.deactivated = append(f.deactivated, in.UserId)
+	return &user.DeactivateAccountResponse{}, nil
+}
+
+// 注销前必须使用当前手机号或邮箱的验证码或密码重新验证身份
+func TestDeactivateRequiresVerification(t *testing.T) {
+	ctx := context.WithValue(context.Background(), types.UserIdKey, json.Number("10"))
+	tests := []struct {
+		name string
+		req  *types.DeactivateRequest
+		err  error
+	}{
+		{name: "empty", req: &types.DeactivateRequest{}, err: code.DeactivateVerifyEmpty},
+		{name: "code without mobile", req: &types.DeactivateRequest{VerificationCode: "123456"}, err: code.DeactivateVerifyEmpty},
+		{name: "other user's mobile", req: &types.DeactivateRequest{Mobile: "13800138000", VerificationCode: "123456"}, err: code.MobileNotMatch},
+		{name: "other user's email", req: &types.DeactivateRequest{Email: "a@example.com", VerificationCode: "123456"}, err: code.EmailNotMatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := &fakeUserRPC{mobileOwner: 11, emailOwner: 11}
+			_, err := NewDeactivateLogic(ctx, &svc.ServiceContext{UserRPC: rpc}).Deactivate(tt.req)
+			if err != tt.err {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if len(rpc.deactivated) > 0 {
+				t.Errorf("DeactivateAccount called: %v", rpc.deactivated)
+			}
+		})
+	}
+}
+
+// 只绑定了邮箱的用户使用邮箱验证码注销
+func TestDeactivateByEmailCode(t *testing.T) {
+	rds, store := newTestRedis(t)
+	email := "a@example.com"
+	codeKey := fmt.Sprintf(prefixActivation, email)
+	store.set(codeKey, "123456")
+	rpc := &fakeUserRPC{emailOwner: 10}
+	svcCtx := &svc.ServiceContext{
+		UserRPC:      rpc,
+		BizRedis:     rds,
+		RevokeStore:  jwt.NewRevokeStore(rds),
+		SessionStore: session.NewStore(rds, 3600),
+	}
+	ctx := context.WithValue(context.Background(), types.UserIdKey, json.Number("10"))
+
+	_, err := NewDeactivateLogic(ctx, svcCtx).Deactivate(&types.DeactivateRequest{Email: " A@example.com ", VerificationCode: "123456"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rpc.deactivated) != 1 || rpc.deactivated[0] != 10 {
+		t.Errorf("deactivated = %v", rpc.deactivated)
+	}
+	if _, ok := store.get(codeKey); ok {
+		t.Error("verification code not deleted")
+	}
+}
+
+func TestCheckVerificationCode(t *testing.T) {
+	rds, store := newTestRedis(t)
+	ctx := context.Background()
+	mobile := "13800138000"
+	codeKey := fmt.Sprintf(prefixActivation, mobile)
+	failKey := prefixVerificationFailLimit + "fail#" + mobile
+	store.set(codeKey, "123456")
+
+	// 错误的验证码累加次数，正确后清零
+	if err := checkVerificationCode(ctx, rds, mobile, "000000"); err != code.VerificationCodeError {
+		t.Fatalf("wrong code err = %v", err)
+	}
+	if v, _ := store.get(failKey); v != "1" {
+		t.Errorf("fail count = %q, want 1", v)
+	}
+	if err := checkVerificationCode(ctx, rds, mobile, "123456"); err != nil {
+		t.Fatalf("right code err = %v", err)
+	}
+	if _, ok := store.get(failKey); ok {
+		t.Error("fail count not reset")
+	}
+
+	// 次数用完之后正确的验证码也被拒绝，并且验证码作废
+	for i := 0; i < maxVerificationFail; i++ {
+		if err := checkVerificationCode(ctx, rds, mobile, "000000"); err != code.VerificationCodeError {
+			t.Fatalf("attempt %d err = %v", i, err)
+		}
+	}
+	if err := checkVerificationCode(ctx, rds, mobile, "123456"); err != code.VerificationCodeLocked {
+		t.Fatalf("over limit err = %v", err)
+	}
+	if _, ok := store.get(codeKey); ok {
+		t.Error("verification code not deleted after lock")
+	}
+	store.set(codeKey, "123456")
+	if err := checkVerificationCode(ctx, rds, mobile, "123456"); err != code.VerificationCodeLocked {
+		t.Fatalf("locked err = %v", err)
+	}
+}
+
+func TestCheckVerificationCodeExpired(t *testing.T) {
+	rds, _ := newTestRedis(t)
+	if err := checkVerificationCode(context.Background(), rds, "13800138000", "123456"); err != code.VerificationCodeExpired {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+// 并发的错误尝试在比较之前计数，最多只有maxVerificationFail次没有被锁定
+// 锁定时验证码被删除，没有被锁定的尝试也可能读不到验证码
+func TestCheckVerificationCodeConcurrent(t *testing.T) {
+	rds, store := newTestRedis(t)
+	mobile := "13800138000"
+	store.set(fmt.Sprintf(prefixActivation, mobile), "123456")
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		compared int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := checkVerificationCode(context.Background(), rds, mobile, "000000")
+			if err != code.VerificationCodeLocked {
+				mu.Lock()
+				compared++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if compared != maxVerificationFail {
+		t.Errorf("compared = %d, want %d", compared, maxVerificationFail)
+	}
+}
@@ -0,0 +1,66 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/pkg/encrypt"
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/session"
+	"myBeyond/pkg/xcode"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LogoutLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutLogic {
+	return &LogoutLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LogoutLogic) Logout(req *types.LogoutRequest) (*types.LogoutResponse, error) {
+	// 1、吊销当前的access token
+	claims, ok := jwt.FromContext(l.ctx)
+	if !ok || len(claims.Id) == 0 {
+		return nil, xcode.Unauthorized
+	}
+	err := l.svcCtx.RevokeStore.Revoke(l.ctx, claims.Id, claims.ExpireAt)
+	if err != nil {
+		logx.Errorf("Revoke tokenId: %s error: %v", claims.Id, err)
+		return nil, err
+	}
+
+	// 2、同时作废refresh token，与刷新时轮换使用同一个标记
+	req.RefreshToken = strings.TrimSpace(req.RefreshToken)
+	if len(req.RefreshToken) > 0 {
+		key := fmt.Sprintf(prefixRefreshUsed, encrypt.Md5Sum([]byte(req.RefreshToken)))
+		err = l.svcCtx.BizRedis.SetexCtx(l.ctx, key, "1", int(l.svcCtx.Config.Auth.RefreshExpire))
+		if err != nil {
+			logx.Errorf("SetexCtx key: %s error: %v", key, err)
+			return nil, err
+		}
+	}
+
+	// 3、删除当前会话，没有会话ID的token是会话功能上线前签发的
+	sessionId, ok := claims.String(session.ClaimKey)
+	userId, exists := claims.Fields[types.UserIdKey]
+	if ok && len(sessionId) > 0 && exists {
+		if _, err = l.svcCtx.SessionStore.Delete(l.ctx, fmt.Sprint(userId), sessionId); err != nil {
+			logx.Errorf("Delete session userId: %v sessionId: %s error: %v", userId, sessionId, err)
+			return nil, err
+		}
+	}
+
+	return &types.LogoutResponse{}, nil
+}
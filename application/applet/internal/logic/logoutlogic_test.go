@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/session"
+)
+
+// 退出登录时吊销当前token并删除当前会话，其他会话不受影响
+func TestLogoutDeletesSession(t *testing.T) {
+	rds, fake := newTestRedis(t)
+	fake.hset("biz#session#user#10", "sid-1", "{}")
+	fake.hset("biz#session#user#10", "sid-2", "{}")
+	svcCtx := &svc.ServiceContext{
+		BizRedis:     rds,
+		RevokeStore:  jwt.NewRevokeStore(rds),
+		SessionStore: session.NewStore(rds, 3600),
+	}
+	ctx := jwt.NewContext(context.Background(), &jwt.Claims{
+		Id:       "token-1",
+		ExpireAt: time.Now().Add(time.Hour).Unix(),
+		Fields:   map[string]interface{}{types.UserIdKey: "10", session.ClaimKey: "sid-1"},
+	})
+
+	if _, err := NewLogoutLogic(ctx, svcCtx).Logout(&types.LogoutRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fake.get("biz#token#revoked#token-1"); !ok {
+		t.Error("token not revoked")
+	}
+	if _, ok := fake.hget("biz#session#user#10", "sid-1"); ok {
+		t.Error("current session not deleted")
+	}
+	if _, ok := fake.hget("biz#session#user#10", "sid-2"); !ok {
+		t.Error("other session deleted")
+	}
+}
@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/pkg/encrypt"
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/session"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const prefixRefreshUsed = "biz#refresh#used#%s"
+
+type RefreshLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRefreshLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshLogic {
+	return &RefreshLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RefreshLogic) Refresh(req *types.RefreshRequest) (*types.RefreshResponse, error) {
+	// 1、校验参数
+	req.RefreshToken = strings.TrimSpace(req.RefreshToken)
+	if len(req.RefreshToken) == 0 {
+		return nil, code.RefreshTokenEmpty
+	}
+
+	// 2、校验refresh token并签发新的token
+	auth := l.svcCtx.Config.Auth
+	token, err := jwt.RefreshTokens(jwt.TokenOptions{
+		AccessSecret:  auth.AccessSecret,
+		AccessKeys:    l.svcCtx.AccessKeys,
+		AccessExpire:  auth.AccessExpire,
+		RefreshSecret: auth.RefreshSecret,
+		RefreshExpire: auth.RefreshExpire,
+		RefreshAfter:  auth.RefreshAfter,
+	}, req.RefreshToken)
+	switch err {
+	case nil:
+	case jwt.ErrInvalidToken:
+		return nil, code.RefreshTokenInvalid
+	case jwt.ErrRefreshTooEarly:
+		return nil, code.RefreshTooEarly
+	default:
+		logx.Errorf("RefreshTokens error: %v", err)
+		return nil, err
+	}
+
+	// 3、用户的token被整体吊销后，之前的refresh token也不能再使用
+	claims, err := jwt.ParseUnverified(req.RefreshToken)
+	if err != nil {
+		return nil, code.RefreshTokenInvalid
+	}
+	if userId, ok := claims.Fields[types.UserIdKey]; ok {
+		revoked, err := l.svcCtx.RevokeStore.IsUserRevoked(l.ctx, fmt.Sprint(userId), claims.IssuedAtMilli)
+		if err != nil {
+			logx.Errorf("IsUserRevoked userId: %v error: %v", userId, err)
+		}
+		if revoked {
+			return nil, code.RefreshTokenInvalid
+		}
+		// 会话被删除后refresh token同时失效，没有会话ID的是会话功能上线前签发的
+		if sessionId, ok := claims.String(session.ClaimKey); ok && len(sessionId) > 0 {
+			exists, err := l.svcCtx.SessionStore.Touch(l.ctx, fmt.Sprint(userId), sessionId)
+			if err != nil {
+				logx.Errorf("Touch userId: %v sessionId: %s error: %v", userId, sessionId, err)
+			} else if !exists {
+				return nil, code.RefreshTokenInvalid
+			}
+		}
+	}
+
+	// 4、refresh token轮换，旧的只能使用一次
+	key := fmt.Sprintf(prefixRefreshUsed, encrypt.Md5Sum([]byte(req.RefreshToken)))
+	ok, err := l.svcCtx.BizRedis.SetnxExCtx(l.ctx, key, "1", int(auth.RefreshExpire))
+	if err != nil {
+		logx.Errorf("SetnxExCtx key: %s error: %v", key, err)
+		return nil, err
+	}
+	if !ok {
+		return nil, code.RefreshTokenInvalid
+	}
+
+	return &types.RefreshResponse{
+		Token: types.Token{
+			AccessToken:   token.AccessToken,
+			AccessExpire:  token.AccessExpire,
+			RefreshToken:  token.RefreshToken,
+			RefreshExpire: token.RefreshExpire,
+			RefreshAfter:  token.RefreshAfter,
+		},
+	}, nil
+}
@@ -9,8 +9,6 @@ import (
 	"myBeyond/application/applet/internal/svc"
 	"myBeyond/application/applet/internal/types"
 	"myBeyond/application/user/rpc/user"
-	"myBeyond/pkg/encrypt"
-	"myBeyond/pkg/jwt"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -51,44 +49,28 @@ func (l *RegisterLogic) Register(req *types.RegisterRequest) (resp *types.Regist
 		return nil, code.VerificationCodeEmpty
 	}
 
-	cacheCode, err := getActivationCache(req.Mobile, l.svcCtx.BizRedis)
+	err = checkVerificationCode(l.ctx, l.svcCtx.BizRedis, req.Mobile, req.VerificationCode)
 	if err != nil {
 		return nil, err
-	} else if cacheCode == "" {
-		return nil, errors.New("verification code expired")
-	} else if cacheCode != req.VerificationCode {
-		return nil, errors.New("verification code failed")
 	}
 
-	// 3、密码做加密处理
+	// 3、手机号和密码由用户服务加密后存储
 	req.Password = strings.TrimSpace(req.Password)
 	if len(req.Password) == 0 {
 		return nil, errors.New("password is empty")
 	}
-	req.Password = encrypt.EncPassword(req.Password)
-
-	mobile, err := encrypt.EncMobile(req.Mobile)
-	if err != nil {
-		logx.Errorf("EncMobile mobile: %s error: %v", req.Mobile, err)
-		return nil, err
-	}
 
 	// 4、注册，返回jwt
 	regRet, err := l.svcCtx.UserRPC.Register(l.ctx, &user.RegisterRequest{
 		Username: req.Name,
-		Mobile:   mobile,
+		Mobile:   req.Mobile,
+		Password: req.Password,
 	})
 	if err != nil {
 		logx.Errorf("Register error: %v", err)
 		return nil, err
 	}
-	token, err := jwt.BuildTokens(jwt.TokenOptions{
-		AccessSecret: l.svcCtx.Config.Auth.AccessSecret,
-		AccessExpire: l.svcCtx.Config.Auth.AccessExpire,
-		Fields: map[string]interface{}{
-			"userId": regRet.UserId,
-		},
-	})
+	token, err := sessionTokens(l.ctx, l.svcCtx, regRet.UserId)
 	if err != nil {
 		logx.Errorf("BuildTokens error: %v", err)
 		return nil, err
@@ -97,9 +79,6 @@ func (l *RegisterLogic) Register(req *types.RegisterRequest) (resp *types.Regist
 	delActivationCache(req.Mobile, req.VerificationCode, l.svcCtx.BizRedis)
 	return &types.RegisterResponse{
 		UserId: regRet.UserId,
-		Token: types.Token{
-			AccessToken:  token.AccessToken,
-			AccessExpire: token.AccessExpire,
-		},
+		Token:  token,
 	}, nil
 }
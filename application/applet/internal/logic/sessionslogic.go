@@ -0,0 +1,98 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/session"
+	"myBeyond/pkg/util"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/utils"
+)
+
+const (
+	maxDeviceLen    = 64
+	maxUserAgentLen = 256
+)
+
+type SessionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SessionsLogic {
+	return &SessionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SessionsLogic) Sessions() (resp *types.SessionsResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		return nil, err
+	}
+
+	// 1、当前请求使用的会话
+	var current string
+	if claims, ok := jwt.FromContext(l.ctx); ok {
+		current, _ = claims.String(session.ClaimKey)
+	}
+
+	// 2、按最后活跃时间倒序返回
+	sessions, err := l.svcCtx.SessionStore.List(l.ctx, strconv.FormatInt(userId, 10))
+	if err != nil {
+		logx.Errorf("List sessions userId: %d error: %v", userId, err)
+		return nil, err
+	}
+	list := make([]types.Session, 0, len(sessions))
+	for _, s := range sessions {
+		list = append(list, types.Session{
+			Id:         s.Id,
+			Device:     s.Device,
+			Ip:         s.Ip,
+			UserAgent:  s.UserAgent,
+			CreateTime: s.CreateTime,
+			LastSeen:   s.LastSeen,
+			Current:    s.Id == current,
+		})
+	}
+
+	return &types.SessionsResponse{Sessions: list}, nil
+}
+
+// 为当前设备创建会话并签发token，超过同时在线设备数时最早不活跃的设备被下线
+func sessionTokens(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) (types.Token, error) {
+	// 客户端信息由ClientInfo中间件从请求中取出，IP只采信可信代理追加的地址
+	client := util.ClientInfoFromContext(ctx)
+	s := &session.Session{
+		Id:        utils.NewUuid(),
+		Device:    truncate(client.Device, maxDeviceLen),
+		Ip:        client.Ip,
+		UserAgent: truncate(client.UserAgent, maxUserAgentLen),
+	}
+	evicted, err := svcCtx.SessionStore.Create(ctx, strconv.FormatInt(userId, 10), s, svcCtx.Config.Session.MaxSessions)
+	if err != nil {
+		logx.Errorf("Create session userId: %d error: %v", userId, err)
+		return types.Token{}, err
+	}
+	if len(evicted) > 0 {
+		logx.Infof("userId: %d sessions evicted: %v", userId, evicted)
+	}
+
+	return buildTokens(svcCtx, userId, s.Id)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateUserInfoLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateUserInfoLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateUserInfoLogic {
+	return &UpdateUserInfoLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateUserInfoLogic) UpdateUserInfo(req *types.UpdateUserInfoRequest) (resp *types.UserInfoResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		return nil, err
+	}
+
+	// 只把请求中出现的字段传给user rpc，校验在user rpc中完成
+	u, err := l.svcCtx.UserRPC.UpdateProfile(l.ctx, &user.UpdateProfileRequest{
+		UserId:   userId,
+		Username: req.Username,
+		Avatar:   req.Avatar,
+	})
+	if err != nil {
+		l.Logger.Errorf("UpdateProfile userId: %d error: %v", userId, err)
+		return nil, err
+	}
+
+	return &types.UserInfoResponse{
+		UserId:   u.UserId,
+		Username: u.Username,
+		Avatar:   u.Avatar,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"time"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+	"myBeyond/pkg/imaging"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	maxAvatarSize      = 2 << 20 // 2MB
+	maxAvatarPixels    = 4096    // 宽高上限，防止解码超大图片
+	avatarSize         = 256
+	avatarJpegQuality  = 90
+	avatarFormFileName = "avatar"
+)
+
+type UploadAvatarLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUploadAvatarLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UploadAvatarLogic {
+	return &UploadAvatarLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UploadAvatarLogic) UploadAvatar(req *http.Request) (resp *types.UploadAvatarResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		return nil, err
+	}
+
+	// 1、读取头像文件，检查大小和类型，表单已经在handler中解析
+	file, header, err := req.FormFile(avatarFormFileName)
+	if err != nil {
+		return nil, code.AvatarEmpty
+	}
+	defer file.Close()
+	if header.Size > maxAvatarSize {
+		return nil, code.AvatarTooLarge
+	}
+	data, err := io.ReadAll(io.LimitReader(file, maxAvatarSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxAvatarSize {
+		return nil, code.AvatarTooLarge
+	}
+	switch http.DetectContentType(data) {
+	case "image/jpeg", "image/png":
+	default:
+		return nil, code.AvatarTypeInvalid
+	}
+
+	// 2、先只解析宽高，再解码图片
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width > maxAvatarPixels || cfg.Height > maxAvatarPixels {
+		return nil, code.AvatarTypeInvalid
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, code.AvatarTypeInvalid
+	}
+
+	// 3、裁剪缩放为正方形，上传到OSS
+	bucket, err := l.svcCtx.OssClient.Bucket(l.svcCtx.Config.Oss.BucketName)
+	if err != nil {
+		l.Logger.Errorf("get bucket failed, err: %v", err)
+		return nil, code.AvatarUploadFailed
+	}
+	buf, err := encodeAvatar(img, avatarSize)
+	if err != nil {
+		l.Logger.Errorf("encode avatar userId: %d error: %v", userId, err)
+		return nil, code.AvatarUploadFailed
+	}
+	objectKey := fmt.Sprintf("avatar/%d/%d_%d.jpg", userId, time.Now().UnixMilli(), avatarSize)
+	if err = bucket.PutObject(objectKey, buf); err != nil {
+		l.Logger.Errorf("put object failed, err: %v", err)
+		return nil, code.AvatarUploadFailed
+	}
+
+	// 4、通过user rpc写回头像地址
+	avatar := l.genFileURL(objectKey)
+	_, err = l.svcCtx.UserRPC.UpdateProfile(l.ctx, &user.UpdateProfileRequest{
+		UserId: userId,
+		Avatar: &avatar,
+	})
+	if err != nil {
+		l.Logger.Errorf("UpdateProfile userId: %d error: %v", userId, err)
+		return nil, err
+	}
+
+	return &types.UploadAvatarResponse{
+		Avatar: avatar,
+	}, nil
+}
+
+// 缩放后铺在白色背景上，统一编码为jpeg
+func encodeAvatar(img image.Image, size int) (*bytes.Buffer, error) {
+	square := imaging.ResizeSquare(img, size)
+	dst := image.NewRGBA(square.Bounds())
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), square, image.Point{}, draw.Over)
+
+	buf := new(bytes.Buffer)
+	err := jpeg.Encode(buf, dst, &jpeg.Options{Quality: avatarJpegQuality})
+	return buf, err
+}
+
+// 生成访问的URL
+func (l *UploadAvatarLogic) genFileURL(objectKey string) string {
+	return fmt.Sprintf("https://%s.%s/%s", l.svcCtx.Config.Oss.BucketName, l.svcCtx.Config.Oss.Endpoint, objectKey)
+}
@@ -3,23 +3,32 @@ package logic
 import (
 	"context"
 	"fmt"
+	"math"
 	"myBeyond/pkg/util"
 	"strconv"
+	"strings"
 	"time"
 
+	"myBeyond/application/applet/internal/code"
 	"myBeyond/application/applet/internal/svc"
 	"myBeyond/application/applet/internal/types"
 	"myBeyond/application/user/rpc/user"
+	"myBeyond/pkg/limit"
+	"myBeyond/pkg/xcode"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 )
 
 const (
-	prefixVerificationCount = "biz#verification#count#%s"
-	verificationLimitPerDay = 10
-	expireActivation        = 60 * 30
-	prefixActivation        = "biz#activation#%s"
+	prefixVerificationCount     = "biz#verification#count#%s"
+	verificationLimitPerDay     = 10
+	expireActivation            = 60 * 30
+	prefixActivation            = "biz#activation#%s"
+	prefixVerificationInterval  = "biz#verification#interval#%s"
+	prefixVerificationFailLimit = "biz#verification#"
+	maxVerificationFail         = 5       // 验证码最多允许错误的次数
+	expireVerificationLock      = 60 * 15 // 错误次数过多后的锁定时间
 )
 
 type VerificationLogic struct {
@@ -37,49 +46,163 @@ func NewVerificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Veri
 }
 
 func (l *VerificationLogic) Verification(req *types.VerificationRequest) (resp *types.VerificationResponse, err error) {
-	// 1、查找发送验证码的次数
-	count, err := l.getVerificationCount(req.Mobile)
-	if err != nil {
-		logx.Errorf("getVerificationCount mobile: %s error: %v", req.Mobile, err)
+	req.Mobile = strings.TrimSpace(req.Mobile)
+	if len(req.Mobile) == 0 {
+		return nil, code.LoginMobileEmpty
 	}
-	// 1.2、判断是否超过限制
-	if count > verificationLimitPerDay {
+
+	err = l.sendCode(req.Mobile, func(verificationCode string) error {
+		_, err := l.svcCtx.UserRPC.SendSms(l.ctx, &user.SendSmsRequest{
+			Mobile:     req.Mobile,
+			TemplateId: l.svcCtx.Config.Sms.VerificationTemplate,
+			Params:     map[string]string{"code": verificationCode},
+		})
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
-	// 2、如果没有超过限制，判断缓存中是否有验证码
-	code, err := getActivationCache(req.Mobile, l.svcCtx.BizRedis)
+
+	return &types.VerificationResponse{}, nil
+}
+
+// sendCode 限流后生成验证码，通过send发送给target，target为手机号或邮箱
+func (l *VerificationLogic) sendCode(target string, send func(verificationCode string) error) error {
+	// 1、同一目标的最小发送间隔
+	err := l.takeResendInterval(target)
+	if err != nil {
+		return err
+	}
+	// 2、查找发送验证码的次数，判断是否超过每天的限制
+	count, err := l.getVerificationCount(target)
 	if err != nil {
-		logx.Errorf("getActivationCache mobile: %s error: %v", req.Mobile, err)
+		logx.Errorf("getVerificationCount target: %s error: %v", target, err)
+	}
+	if count >= verificationLimitPerDay {
+		l.releaseResendInterval(target)
+		return code.VerificationDailyLimit
+	}
+	// 2.1、滑动窗口限流：手机号或邮箱、客户端IP、全局
+	reservations, err := l.takeVerificationLimits(target, util.ClientInfoFromContext(l.ctx).Ip)
+	if err != nil {
+		l.releaseResendInterval(target)
+		return err
+	}
+	// 3、如果没有超过限制，判断缓存中是否有验证码
+	verificationCode, err := getActivationCache(target, l.svcCtx.BizRedis)
+	if err != nil {
+		logx.Errorf("getActivationCache target: %s error: %v", target, err)
 	}
 
-	if len(code) == 0 {
-		// 2.1、没有验证码重新生成
-		code = util.RandomNumeric(6)
+	issued := len(verificationCode) == 0
+	if issued {
+		// 3.1、没有验证码重新生成
+		verificationCode = util.RandomNumeric(6)
 	}
-	// 3、发送验证码
-	_, err = l.svcCtx.UserRPC.SendSms(l.ctx, &user.SendSmsRequest{
-		Mobile: req.Mobile,
-	})
+	// 4、发送验证码
+	if err = send(verificationCode); err != nil {
+		logx.Errorf("send verification code target: %s error: %v", target, err)
+		l.releaseResendInterval(target)
+		l.cancelVerificationLimits(reservations)
+		return err
+	}
+
+	// 5、保存验证码
+	err = saveActivationCache(target, verificationCode, l.svcCtx.BizRedis)
 	if err != nil {
-		logx.Errorf("sendSms mobile: %s error: %v", req.Mobile, err)
-		return nil, err
+		logx.Errorf("saveActivationCache target: %s error: %v", target, err)
+		return err
+	}
+	// 5.1、新的验证码重新计算错误次数，重发原来的验证码时不清零
+	if issued {
+		if err = verificationFailLimit(l.svcCtx.BizRedis).ResetCtx(l.ctx, target); err != nil {
+			logx.Errorf("ResetCtx target: %s error: %v", target, err)
+		}
 	}
 
-	// 4、保存验证码
-	err = saveActivationCache(req.Mobile, code, l.svcCtx.BizRedis)
+	// 6、验证码获取次数+1
+	err = l.incrVerificationCount(target)
 	if err != nil {
-		logx.Errorf("saveActivationCache mobile: %s error: %v", req.Mobile, err)
-		return nil, err
+		logx.Errorf("incrVerificationCount target: %s error: %v", target, err)
+	}
+
+	return nil
+}
+
+// 同一手机号在Interval秒内只能发送一次，被拒绝时返回剩余等待时间
+func (l *VerificationLogic) takeResendInterval(mobile string) error {
+	interval := l.svcCtx.Config.VerificationLimit.Interval
+	if interval <= 0 {
+		return nil
 	}
-	
-	// 5、验证码获取次数+1
-	err = l.incrVerificationCount(req.Mobile)
+	key := fmt.Sprintf(prefixVerificationInterval, mobile)
+	ok, err := l.svcCtx.BizRedis.SetnxExCtx(l.ctx, key, "1", interval)
 	if err != nil {
-		logx.Errorf("incrVerificationCount mobile: %s error: %v", req.Mobile, err)
+		logx.Errorf("SetnxExCtx key: %s error: %v", key, err)
+		return nil
+	}
+	if ok {
+		return nil
 	}
 
-	return &types.VerificationResponse{}, nil
+	ttl, err := l.svcCtx.BizRedis.TtlCtx(l.ctx, key)
+	if err != nil || ttl <= 0 {
+		ttl = interval
+	}
+	return xcode.Errorf(code.VerificationTooFrequent, "验证码发送过于频繁，请%d秒后重试", ttl)
+}
 
+// 本次没有发送成功，释放发送间隔，允许立即重试
+func (l *VerificationLogic) releaseResendInterval(mobile string) {
+	key := fmt.Sprintf(prefixVerificationInterval, mobile)
+	if _, err := l.svcCtx.BizRedis.DelCtx(l.ctx, key); err != nil {
+		logx.Errorf("DelCtx key: %s error: %v", key, err)
+	}
+}
+
+// 依次检查手机号、客户端IP和全局的滑动窗口，redis异常时放行
+// 任意一个被拒绝时归还已经占用的配额，全部通过时返回占用的配额，发送失败时归还
+func (l *VerificationLogic) takeVerificationLimits(mobile, clientIp string) ([]verificationReservation, error) {
+	limits := []struct {
+		limit *limit.SlidingWindowLimit
+		key   string
+		code  xcode.Code
+		msg   string
+	}{
+		{l.svcCtx.VerificationMobileLimit, mobile, code.VerificationMobileLimit, "该手机号验证码发送次数过多，请%d秒后重试"},
+		{l.svcCtx.VerificationIpLimit, clientIp, code.VerificationIpLimit, "当前IP验证码发送次数过多，请%d秒后重试"},
+		{l.svcCtx.VerificationGlobalLimit, "", code.VerificationGlobalLimit, "验证码服务繁忙，请%d秒后重试"},
+	}
+
+	reservations := make([]verificationReservation, 0, len(limits))
+	for _, v := range limits {
+		r, err := v.limit.ReserveCtx(l.ctx, v.key)
+		if err != nil {
+			logx.Errorf("ReserveCtx key: %s error: %v", v.key, err)
+			continue
+		}
+		if !r.OK {
+			l.cancelVerificationLimits(reservations)
+			return nil, xcode.Errorf(v.code, v.msg, int64(math.Ceil(r.Wait.Seconds())))
+		}
+		reservations = append(reservations, verificationReservation{limit: v.limit, reservation: r})
+	}
+
+	return reservations, nil
+}
+
+type verificationReservation struct {
+	limit       *limit.SlidingWindowLimit
+	reservation *limit.Reservation
+}
+
+// 验证码没有发送出去，归还占用的限流配额
+func (l *VerificationLogic) cancelVerificationLimits(reservations []verificationReservation) {
+	for _, v := range reservations {
+		if err := v.limit.CancelCtx(l.ctx, v.reservation); err != nil {
+			logx.Errorf("CancelCtx error: %v", err)
+		}
+	}
 }
 
 // 从redis中查找prefixVerificationCount+mobile对应的值
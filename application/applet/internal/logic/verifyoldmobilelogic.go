@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/utils"
+)
+
+const (
+	prefixChangeMobileTicket = "biz#mobile#change#ticket#%s"
+	expireChangeMobileTicket = 60 * 10 // 原手机号验证通过后，需要在此时间内完成更换
+)
+
+type VerifyOldMobileLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyOldMobileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyOldMobileLogic {
+	return &VerifyOldMobileLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VerifyOldMobileLogic) VerifyOldMobile(req *types.VerifyOldMobileRequest) (resp *types.VerifyOldMobileResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		return nil, err
+	}
+
+	// 1、检查参数
+	req.Mobile = strings.TrimSpace(req.Mobile)
+	if len(req.Mobile) == 0 {
+		return nil, code.LoginMobileEmpty
+	}
+	req.VerificationCode = strings.TrimSpace(req.VerificationCode)
+	if len(req.VerificationCode) == 0 {
+		return nil, code.VerificationCodeEmpty
+	}
+
+	// 2、手机号必须属于当前用户
+	u, err := l.svcCtx.UserRPC.FindByMobile(l.ctx, &user.FindByMobileRequest{Mobile: req.Mobile})
+	if err != nil {
+		logx.Errorf("FindByMobile error: %v", err)
+		return nil, err
+	}
+	if u == nil || u.UserId != userId {
+		return nil, code.MobileNotMatch
+	}
+
+	// 3、校验原手机号的验证码
+	err = checkVerificationCode(l.ctx, l.svcCtx.BizRedis, req.Mobile, req.VerificationCode)
+	if err != nil {
+		return nil, err
+	}
+	delActivationCache(req.Mobile, req.VerificationCode, l.svcCtx.BizRedis)
+
+	// 4、生成更换手机号的凭证
+	ticket := utils.NewUuid()
+	key := fmt.Sprintf(prefixChangeMobileTicket, ticket)
+	err = l.svcCtx.BizRedis.SetexCtx(l.ctx, key, strconv.FormatInt(userId, 10), expireChangeMobileTicket)
+	if err != nil {
+		logx.Errorf("SetexCtx key: %s error: %v", key, err)
+		return nil, err
+	}
+
+	return &types.VerifyOldMobileResponse{
+		Ticket: ticket,
+		Expire: time.Now().Unix() + expireChangeMobileTicket,
+	}, nil
+}
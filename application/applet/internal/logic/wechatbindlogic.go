@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 微信用户没有填写昵称时的默认用户名
+const defaultWechatUsername = "微信用户"
+
+type WechatBindLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewWechatBindLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WechatBindLogic {
+	return &WechatBindLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *WechatBindLogic) WechatBind(req *types.WechatBindRequest) (resp *types.LoginResponse, err error) {
+	// 1、检查参数
+	req.Mobile = strings.TrimSpace(req.Mobile)
+	if len(req.Mobile) == 0 {
+		return nil, code.LoginMobileEmpty
+	}
+	req.VerificationCode = strings.TrimSpace(req.VerificationCode)
+	if len(req.VerificationCode) == 0 {
+		return nil, code.VerificationCodeEmpty
+	}
+
+	// 2、先校验手机号的验证码，验证码错误时绑定凭证仍然可以使用
+	err = checkVerificationCode(l.ctx, l.svcCtx.BizRedis, req.Mobile, req.VerificationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3、绑定凭证必须有效，取出时同时删除，凭证只能使用一次
+	ticketKey := fmt.Sprintf(prefixWechatBindTicket, strings.TrimSpace(req.BindTicket))
+	val, err := consumeTicket(l.ctx, l.svcCtx.BizRedis, ticketKey)
+	if err != nil {
+		logx.Errorf("consumeTicket key: %s error: %v", ticketKey, err)
+		return nil, err
+	}
+	var identity wechatIdentity
+	if len(val) == 0 || json.Unmarshal([]byte(val), &identity) != nil {
+		return nil, code.WechatBindTicketInvalid
+	}
+
+	// 4、手机号已注册则绑定到该用户，否则注册并在同一个事务中绑定微信身份
+	u, err := l.svcCtx.UserRPC.FindByMobile(l.ctx, &user.FindByMobileRequest{Mobile: req.Mobile})
+	if err != nil {
+		logx.Errorf("FindByMobile error: %v", err)
+		return nil, err
+	}
+	var userId int64
+	if u != nil && u.UserId > 0 {
+		userId = u.UserId
+		_, err = l.svcCtx.UserRPC.BindIdentity(l.ctx, &user.BindIdentityRequest{
+			UserId:   userId,
+			Provider: identityProviderWechat,
+			OpenId:   identity.OpenId,
+			UnionId:  identity.UnionId,
+		})
+		if err != nil {
+			logx.Errorf("BindIdentity userId: %d openId: %s error: %v", userId, identity.OpenId, err)
+			return nil, err
+		}
+	} else {
+		name := strings.TrimSpace(req.Name)
+		if len(name) == 0 {
+			name = defaultWechatUsername
+		}
+		regRet, err := l.svcCtx.UserRPC.Register(l.ctx, &user.RegisterRequest{
+			Username: name,
+			Mobile:   req.Mobile,
+			Identity: &user.RegisterIdentity{
+				Provider: identityProviderWechat,
+				OpenId:   identity.OpenId,
+				UnionId:  identity.UnionId,
+			},
+		})
+		if err != nil {
+			logx.Errorf("Register openId: %s error: %v", identity.OpenId, err)
+			return nil, err
+		}
+		userId = regRet.UserId
+	}
+	delActivationCache(req.Mobile, req.VerificationCode, l.svcCtx.BizRedis)
+
+	// 5、签发token
+	token, err := loginTokens(l.ctx, l.svcCtx, userId)
+	if err != nil {
+		logx.Errorf("BuildTokens error: %v", err)
+		return nil, err
+	}
+
+	return &types.LoginResponse{
+		UserId: userId,
+		Token:  token,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+)
+
+// 验证码错误时不消耗绑定凭证，凭证无效时不删除验证码
+func TestWechatBindChecksCodeFirst(t *testing.T) {
+	rds, store := newTestRedis(t)
+	mobile := "13800138000"
+	codeKey := fmt.Sprintf(prefixActivation, mobile)
+	ticketKey := fmt.Sprintf(prefixWechatBindTicket, "ticket")
+	store.set(codeKey, "123456")
+	store.set(ticketKey, `{"open_id":"open-a"}`)
+	l := NewWechatBindLogic(context.Background(), &svc.ServiceContext{BizRedis: rds})
+
+	_, err := l.WechatBind(&types.WechatBindRequest{Mobile: mobile, VerificationCode: "000000", BindTicket: "ticket"})
+	if err != code.VerificationCodeError {
+		t.Fatalf("wrong code err = %v", err)
+	}
+	if _, ok := store.get(ticketKey); !ok {
+		t.Error("ticket consumed by wrong code")
+	}
+
+	_, err = l.WechatBind(&types.WechatBindRequest{Mobile: mobile, VerificationCode: "123456", BindTicket: "other"})
+	if err != code.WechatBindTicketInvalid {
+		t.Fatalf("invalid ticket err = %v", err)
+	}
+	if _, ok := store.get(codeKey); !ok {
+		t.Error("verification code deleted with invalid ticket")
+	}
+}
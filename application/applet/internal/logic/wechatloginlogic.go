@@ -0,0 +1,111 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"myBeyond/application/applet/internal/code"
+	"myBeyond/application/applet/internal/svc"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/utils"
+)
+
+const (
+	identityProviderWechat = "wechat"
+	prefixWechatBindTicket = "biz#wechat#bind#ticket#%s"
+	expireWechatBindTicket = 60 * 10 // 微信登录后，需要在此时间内完成手机号绑定
+)
+
+// 绑定凭证对应的微信身份
+type wechatIdentity struct {
+	OpenId  string `json:"open_id"`
+	UnionId string `json:"union_id"`
+}
+
+type WechatLoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewWechatLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WechatLoginLogic {
+	return &WechatLoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *WechatLoginLogic) WechatLogin(req *types.WechatLoginRequest) (resp *types.WechatLoginResponse, err error) {
+	// 1、检查参数
+	req.Code = strings.TrimSpace(req.Code)
+	if len(req.Code) == 0 {
+		return nil, code.WechatCodeEmpty
+	}
+
+	// 2、用code换取openId
+	session, err := l.svcCtx.Wechat.Code2Session(l.ctx, req.Code)
+	if err != nil {
+		logx.Errorf("Code2Session error: %v", err)
+		return nil, code.WechatLoginFailed
+	}
+
+	// 3、已经绑定的用户直接签发token，同一开放平台下其他应用绑定过的用户先绑定当前openId
+	u, err := l.svcCtx.UserRPC.FindByIdentity(l.ctx, &user.FindByIdentityRequest{
+		Provider: identityProviderWechat,
+		OpenId:   session.OpenId,
+		UnionId:  session.UnionId,
+	})
+	if err != nil {
+		logx.Errorf("FindByIdentity openId: %s error: %v", session.OpenId, err)
+		return nil, err
+	}
+	if u.UserId > 0 && !u.Bound {
+		_, err = l.svcCtx.UserRPC.BindIdentity(l.ctx, &user.BindIdentityRequest{
+			UserId:   u.UserId,
+			Provider: identityProviderWechat,
+			OpenId:   session.OpenId,
+			UnionId:  session.UnionId,
+		})
+		if err != nil {
+			logx.Errorf("BindIdentity userId: %d openId: %s error: %v", u.UserId, session.OpenId, err)
+			return nil, err
+		}
+	}
+	if u.UserId > 0 {
+		token, err := loginTokens(l.ctx, l.svcCtx, u.UserId)
+		if err != nil {
+			logx.Errorf("BuildTokens error: %v", err)
+			return nil, err
+		}
+		return &types.WechatLoginResponse{
+			UserId: u.UserId,
+			Token:  token,
+		}, nil
+	}
+
+	// 4、未绑定时生成绑定凭证，由客户端验证手机号后完成绑定
+	val, err := json.Marshal(wechatIdentity{OpenId: session.OpenId, UnionId: session.UnionId})
+	if err != nil {
+		return nil, err
+	}
+	ticket := utils.NewUuid()
+	key := fmt.Sprintf(prefixWechatBindTicket, ticket)
+	err = l.svcCtx.BizRedis.SetexCtx(l.ctx, key, string(val), expireWechatBindTicket)
+	if err != nil {
+		logx.Errorf("SetexCtx key: %s error: %v", key, err)
+		return nil, err
+	}
+
+	return &types.WechatLoginResponse{
+		NeedBind:   true,
+		BindTicket: ticket,
+		BindExpire: time.Now().Unix() + expireWechatBindTicket,
+	}, nil
+}
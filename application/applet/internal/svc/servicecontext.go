@@ -2,24 +2,95 @@ package svc
 
 import (
 	"myBeyond/application/applet/internal/config"
+	"myBeyond/application/applet/internal/types"
+	"myBeyond/application/applet/internal/wechat"
 	"myBeyond/application/user/rpc/user"
 	"myBeyond/pkg/interceptors"
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/limit"
+	"myBeyond/pkg/middleware"
+	"myBeyond/pkg/session"
+	"myBeyond/pkg/signature"
+	"myBeyond/pkg/util"
 
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
+const (
+	defaultOssConnectTimeout   = 1
+	defaultOssReadWriteTimeout = 3
+)
+
 type ServiceContext struct {
-	Config   config.Config
-	UserRPC  user.User
-	BizRedis *redis.Redis
+	Config       config.Config
+	UserRPC      user.User
+	OssClient    *oss.Client
+	Wechat       *wechat.Client
+	BizRedis     *redis.Redis
+	AccessKeys   *jwt.Keyring
+	RevokeStore  *jwt.RevokeStore
+	SessionStore *session.Store
+	Signature    rest.Middleware
+	JwtAuth      rest.Middleware
+	TokenRevoke  rest.Middleware
+	Session      rest.Middleware
+	// 所有请求都经过，把设备和客户端IP放到context中
+	ClientInfo rest.Middleware
+
+	// 验证码发送的滑动窗口限流
+	VerificationMobileLimit *limit.SlidingWindowLimit
+	VerificationIpLimit     *limit.SlidingWindowLimit
+	VerificationGlobalLimit *limit.SlidingWindowLimit
+	// 密码登录按客户端IP的滑动窗口限流
+	PasswordLoginIpLimit *limit.SlidingWindowLimit
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	if c.Oss.ConnectTimeout == 0 {
+		c.Oss.ConnectTimeout = defaultOssConnectTimeout
+	}
+	if c.Oss.ReadWriteTimeout == 0 {
+		c.Oss.ReadWriteTimeout = defaultOssReadWriteTimeout
+	}
+	oc, err := oss.New(c.Oss.Endpoint, c.Oss.AccessKeyId, c.Oss.AccessKeySecret,
+		oss.Timeout(c.Oss.ConnectTimeout, c.Oss.ReadWriteTimeout))
+	if err != nil {
+		panic(err)
+	}
+
 	userRPC := zrpc.MustNewClient(c.UserRPC, zrpc.WithUnaryClientInterceptor(interceptors.ClientErrorInterceptor()))
+	rds := redis.New(c.BizRedis.Host, redis.WithPass(c.BizRedis.Pass))
+	revokeStore := jwt.NewRevokeStore(rds)
+	trustedProxies, err := util.ParseCIDRs(c.TrustedProxies)
+	logx.Must(err)
+	sessionStore := session.NewStore(rds, c.Auth.RefreshExpire)
+	accessKeys := jwt.MustNewAccessKeyring(c.Auth.AccessSecret, c.Auth.AccessKeys)
+	vl := c.VerificationLimit
+	pl := c.PasswordLoginLimit
 	return &ServiceContext{
-		Config:   c,
-		UserRPC:  user.NewUser(userRPC),
-		BizRedis: redis.New(c.BizRedis.Host, redis.WithPass(c.BizRedis.Pass)),
+		Config:       c,
+		UserRPC:      user.NewUser(userRPC),
+		OssClient:    oc,
+		Wechat:       wechat.NewClient(c.Wechat),
+		BizRedis:     rds,
+		AccessKeys:   accessKeys,
+		RevokeStore:  revokeStore,
+		SessionStore: sessionStore,
+		Signature:    middleware.NewSignatureMiddleware(signature.NewVerifier(c.RequestSign, signature.NewRedisNonceStore(rds))).Handle,
+		JwtAuth:      middleware.NewJwtAuthMiddleware(accessKeys).Handle,
+		TokenRevoke:  middleware.NewTokenRevokeMiddleware(revokeStore, types.UserIdKey).Handle,
+		Session:      middleware.NewSessionMiddleware(sessionStore, types.UserIdKey).Handle,
+
+		ClientInfo: middleware.NewClientInfoMiddleware(trustedProxies).Handle,
+
+		VerificationMobileLimit: limit.NewSlidingWindowLimit(vl.MobileWindow, vl.MobileQuota, rds, "biz#verification#limit#mobile#"),
+		VerificationIpLimit:     limit.NewSlidingWindowLimit(vl.IpWindow, vl.IpQuota, rds, "biz#verification#limit#ip#"),
+		VerificationGlobalLimit: limit.NewSlidingWindowLimit(vl.GlobalWindow, vl.GlobalQuota, rds, "biz#verification#limit#global"),
+
+		PasswordLoginIpLimit: limit.NewSlidingWindowLimit(pl.IpWindow, pl.IpQuota, rds, "biz#login#password#limit#ip#"),
 	}
 }
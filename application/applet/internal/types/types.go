@@ -2,8 +2,11 @@
 package types
 
 type Token struct {
-	AccessToken  string `json:"access_token"`
-	AccessExpire int64  `json:"access_expire"`
+	AccessToken   string `json:"access_token"`
+	AccessExpire  int64  `json:"access_expire"`
+	RefreshToken  string `json:"refresh_token"`
+	RefreshExpire int64  `json:"refresh_expire"`
+	RefreshAfter  int64  `json:"refresh_after"`
 }
 
 type RegisterRequest struct {
@@ -35,8 +38,141 @@ type LoginResponse struct {
 	Token  Token `json:"token"`
 }
 
+type LoginByPasswordRequest struct {
+	Mobile   string `json:"mobile"`
+	Password string `json:"password"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type RefreshResponse struct {
+	Token Token `json:"token"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token,optional"`
+}
+
+type LogoutResponse struct {
+}
+
 type UserInfoResponse struct {
 	UserId   int64  `json:"user_id"`
 	Username string `json:"username"`
 	Avatar   string `json:"avatar"`
 }
+
+type UpdateUserInfoRequest struct {
+	Username *string `json:"username,optional"`
+	Avatar   *string `json:"avatar,optional"`
+}
+
+type VerifyOldMobileRequest struct {
+	Mobile           string `json:"mobile"`
+	VerificationCode string `json:"verification_code"`
+}
+
+type VerifyOldMobileResponse struct {
+	Ticket string `json:"ticket"`
+	Expire int64  `json:"expire"`
+}
+
+type ChangeMobileRequest struct {
+	Ticket           string `json:"ticket"`
+	Mobile           string `json:"mobile"`
+	VerificationCode string `json:"verification_code"`
+}
+
+type ChangeMobileResponse struct {
+	Token Token `json:"token"`
+}
+
+type Jwk struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JwksResponse struct {
+	Keys []Jwk `json:"keys"`
+}
+
+type WechatLoginRequest struct {
+	Code string `json:"code"`
+}
+
+type WechatLoginResponse struct {
+	UserId     int64  `json:"user_id"`
+	Token      Token  `json:"token"`
+	NeedBind   bool   `json:"need_bind"`
+	BindTicket string `json:"bind_ticket"`
+	BindExpire int64  `json:"bind_expire"`
+}
+
+type WechatBindRequest struct {
+	BindTicket       string `json:"bind_ticket"`
+	Mobile           string `json:"mobile"`
+	VerificationCode string `json:"verification_code"`
+	Name             string `json:"name,optional"`
+}
+
+type DeactivateRequest struct {
+	Mobile           string `json:"mobile,optional"`
+	Email            string `json:"email,optional"` // 只绑定了邮箱的用户使用邮箱验证码
+	VerificationCode string `json:"verification_code,optional"`
+	Password         string `json:"password,optional"`
+}
+
+type DeactivateResponse struct {
+	DeleteTime int64 `json:"delete_time"` // 冷静期结束时间，之前重新登录可以取消注销
+}
+
+type Session struct {
+	Id         string `json:"id"`
+	Device     string `json:"device"`
+	Ip         string `json:"ip"`
+	UserAgent  string `json:"user_agent"`
+	CreateTime int64  `json:"create_time"`
+	LastSeen   int64  `json:"last_seen"`
+	Current    bool   `json:"current"` // 是否为当前请求使用的会话
+}
+
+type SessionsResponse struct {
+	Sessions []Session `json:"sessions"`
+}
+
+type DeleteSessionRequest struct {
+	Id string `path:"id"`
+}
+
+type DeleteSessionResponse struct {
+}
+
+type EmailVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+type EmailLoginRequest struct {
+	Email            string `json:"email"`
+	VerificationCode string `json:"verification_code"`
+	Name             string `json:"name,optional"` // 邮箱未注册时自动注册使用的用户名
+}
+
+type BindEmailRequest struct {
+	Email            string `json:"email"`
+	VerificationCode string `json:"verification_code"`
+}
+
+type BindEmailResponse struct {
+}
+
+type UploadAvatarResponse struct {
+	Avatar string `json:"avatar"`
+}
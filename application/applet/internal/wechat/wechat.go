@@ -0,0 +1,119 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const defaultCode2SessionEndpoint = "https://api.weixin.qq.com/sns/jscode2session"
+
+var ErrNotConfigured = errors.New("wechat: appId is not configured")
+
+type (
+	Config struct {
+		AppId     string `json:",optional"`
+		AppSecret string `json:",optional"`
+		// code2session接口地址，本地测试时可以指向一个stub服务
+		Endpoint string `json:",default=https://api.weixin.qq.com/sns/jscode2session"`
+		Timeout  int64  `json:",default=3000"` // 毫秒
+	}
+
+	// Session code2session返回的用户身份，unionId只有绑定了开放平台才会返回
+	Session struct {
+		OpenId     string
+		UnionId    string
+		SessionKey string
+	}
+
+	// Error 微信接口返回的业务错误
+	Error struct {
+		Code int
+		Msg  string
+	}
+
+	Client struct {
+		appId     string
+		appSecret string
+		endpoint  string
+		client    *http.Client
+	}
+
+	code2SessionResponse struct {
+		OpenId     string `json:"openid"`
+		UnionId    string `json:"unionid"`
+		SessionKey string `json:"session_key"`
+		ErrCode    int    `json:"errcode"`
+		ErrMsg     string `json:"errmsg"`
+	}
+)
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("wechat errcode: %d errmsg: %s", e.Code, e.Msg)
+}
+
+func NewClient(c Config) *Client {
+	endpoint := c.Endpoint
+	if len(endpoint) == 0 {
+		endpoint = defaultCode2SessionEndpoint
+	}
+	return &Client{
+		appId:     c.AppId,
+		appSecret: c.AppSecret,
+		endpoint:  endpoint,
+		client:    &http.Client{Timeout: time.Duration(c.Timeout) * time.Millisecond},
+	}
+}
+
+// Code2Session 用小程序wx.login获取的code换取用户的openId
+func (c *Client) Code2Session(ctx context.Context, jsCode string) (*Session, error) {
+	if len(c.appId) == 0 {
+		return nil, ErrNotConfigured
+	}
+
+	u, err := url.Parse(c.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	query.Set("appid", c.appId)
+	query.Set("secret", c.appSecret)
+	query.Set("js_code", jsCode)
+	query.Set("grant_type", "authorization_code")
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("wechat http status: %d", resp.StatusCode)
+	}
+	// 微信接口的Content-Type为text/plain，直接按json解析
+	var ret code2SessionResponse
+	if err = json.NewDecoder(resp.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+	if ret.ErrCode != 0 {
+		return nil, &Error{Code: ret.ErrCode, Msg: ret.ErrMsg}
+	}
+	if len(ret.OpenId) == 0 {
+		return nil, errors.New("wechat: empty openid")
+	}
+
+	return &Session{
+		OpenId:     ret.OpenId,
+		UnionId:    ret.UnionId,
+		SessionKey: ret.SessionKey,
+	}, nil
+}
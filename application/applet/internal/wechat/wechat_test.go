@@ -0,0 +1,46 @@
+package wechat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCode2Session(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("appid") != "wx123" || q.Get("secret") != "secret" || q.Get("grant_type") != "authorization_code" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		if q.Get("js_code") == "invalid" {
+			w.Write([]byte(`{"errcode":40029,"errmsg":"invalid code"}`))
+			return
+		}
+		w.Write([]byte(`{"openid":"o-1","unionid":"u-1","session_key":"key"}`))
+	}))
+	defer srv.Close()
+
+	client := NewClient(Config{AppId: "wx123", AppSecret: "secret", Endpoint: srv.URL, Timeout: 1000})
+	session, err := client.Code2Session(context.Background(), "code")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.OpenId != "o-1" || session.UnionId != "u-1" || session.SessionKey != "key" {
+		t.Fatalf("unexpected session: %+v", session)
+	}
+
+	_, err = client.Code2Session(context.Background(), "invalid")
+	var wxErr *Error
+	if !errors.As(err, &wxErr) || wxErr.Code != 40029 {
+		t.Fatalf("expected errcode 40029, got %v", err)
+	}
+}
+
+func TestCode2SessionNotConfigured(t *testing.T) {
+	client := NewClient(Config{Endpoint: "http://127.0.0.1:0"})
+	if _, err := client.Code2Session(context.Background(), "code"); err != ErrNotConfigured {
+		t.Fatalf("expected ErrNotConfigured, got %v", err)
+	}
+}
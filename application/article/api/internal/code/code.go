@@ -9,4 +9,5 @@ var (
 	ArtitleTitleEmpty         = xcode.New(30004, "文章标题为空")
 	ArticleContentTooFewWords = xcode.New(30005, "文章内容字数太少")
 	ArticleCoverEmpty         = xcode.New(30006, "文章封面为空")
+	SearchStatusInvalid       = xcode.New(30007, "搜索的文章状态无效")
 )
@@ -1,6 +1,10 @@
 package config
 
 import (
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/signature"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -8,11 +12,16 @@ import (
 type Config struct {
 	rest.RestConf
 	Auth struct {
-		AccessSecret string
+		AccessSecret string `json:",optional"`
+		// 校验access token的公钥，与AccessSecret至少配置一个
+		AccessKeys   []jwt.Key `json:",optional"`
 		AccessExpire int64
 	}
-	ArticleRPC zrpc.RpcClientConf
-	
+	// HMAC请求签名，调用方的app key在Keys中登记
+	RequestSign signature.Conf
+	ArticleRPC  zrpc.RpcClientConf
+	BizRedis    redis.RedisConf
+
 	Oss struct {
 		Endpoint         string
 		AccessKeyId      string
@@ -11,19 +11,41 @@ import (
 
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 	server.AddRoutes(
-		[]rest.Route{
-			{
-				Method:  http.MethodPost,
-				Path:    "/upload/cover",
-				Handler: UploadCoverHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/publish",
-				Handler: PublishHandler(serverCtx),
-			},
-		},
-		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Signature, serverCtx.JwtAuth, serverCtx.TokenRevoke, serverCtx.Session},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/upload/cover",
+					Handler: UploadCoverHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/publish",
+					Handler: PublishHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/:id",
+					Handler: ArticleUpdateHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/drafts",
+					Handler: DraftsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/:id/publish",
+					Handler: PublishDraftHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/search",
+					Handler: SearchHandler(serverCtx),
+				},
+			}...,
+		),
 		rest.WithPrefix("/v1/article"),
 	)
 }
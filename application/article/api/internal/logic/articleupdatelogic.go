@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"myBeyond/application/article/api/internal/code"
+	"myBeyond/application/article/api/internal/svc"
+	"myBeyond/application/article/api/internal/types"
+	"myBeyond/application/article/rpc/types/pb"
+	"myBeyond/pkg/xcode"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ArticleUpdateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewArticleUpdateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ArticleUpdateLogic {
+	return &ArticleUpdateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ArticleUpdate 修改文章，校验规则与发布相同
+func (l *ArticleUpdateLogic) ArticleUpdate(req *types.ArticleUpdateRequest) (resp *types.ArticleUpdateResponse, err error) {
+	if len(req.Title) == 0 {
+		return nil, code.ArtitleTitleEmpty
+	}
+	if len(req.Content) < minContentLen {
+		return nil, code.ArticleContentTooFewWords
+	}
+	if len(req.Cover) == 0 {
+		return nil, code.ArticleCoverEmpty
+	}
+
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		logx.Errorf("l.ctx.Value error: %v", err)
+		return nil, xcode.NoLogin
+	}
+	_, err = l.svcCtx.ArticleRPC.ArticleUpdate(l.ctx, &pb.ArticleUpdateRequest{
+		UserId:      userId,
+		ArticleId:   req.ArticleId,
+		Title:       req.Title,
+		Content:     req.Content,
+		Description: req.Description,
+		Cover:       req.Cover,
+	})
+	if err != nil {
+		logx.Errorf("l.svcCtx.ArticleRPC.ArticleUpdate articleId: %d userId: %d error: %v", req.ArticleId, userId, err)
+		return nil, err
+	}
+
+	return &types.ArticleUpdateResponse{}, nil
+}
@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"myBeyond/application/article/api/internal/svc"
+	"myBeyond/application/article/api/internal/types"
+	"myBeyond/application/article/rpc/types/pb"
+	"myBeyond/pkg/xcode"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DraftsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDraftsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DraftsLogic {
+	return &DraftsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Drafts 当前用户的草稿和定时发布的文章
+func (l *DraftsLogic) Drafts(req *types.DraftsRequest) (resp *types.DraftsResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		logx.Errorf("l.ctx.Value error: %v", err)
+		return nil, xcode.NoLogin
+	}
+	dret, err := l.svcCtx.ArticleRPC.Drafts(l.ctx, &pb.DraftsRequest{
+		UserId:   userId,
+		Cursor:   req.Cursor,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		logx.Errorf("l.svcCtx.ArticleRPC.Drafts req: %v userId: %d error: %v", req, userId, err)
+		return nil, err
+	}
+
+	drafts := make([]types.DraftItem, 0, len(dret.Drafts))
+	for _, d := range dret.Drafts {
+		drafts = append(drafts, types.DraftItem{
+			ArticleId:   d.ArticleId,
+			Title:       d.Title,
+			Description: d.Description,
+			Cover:       d.Cover,
+			Status:      d.Status,
+			PublishTime: d.PublishTime,
+			UpdateTime:  d.UpdateTime,
+		})
+	}
+
+	return &types.DraftsResponse{
+		Drafts: drafts,
+		IsEnd:  dret.IsEnd,
+		Cursor: dret.Cursor,
+	}, nil
+}
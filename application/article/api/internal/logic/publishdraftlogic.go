@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"myBeyond/application/article/api/internal/code"
+	"myBeyond/application/article/api/internal/svc"
+	"myBeyond/application/article/api/internal/types"
+	"myBeyond/application/article/rpc/types/pb"
+	"myBeyond/pkg/xcode"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PublishDraftLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPublishDraftLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishDraftLogic {
+	return &PublishDraftLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// PublishDraft 发布草稿，publish_time为0时立即发布，内容和封面的校验规则与发布相同
+func (l *PublishDraftLogic) PublishDraft(req *types.PublishDraftRequest) (resp *types.PublishDraftResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		logx.Errorf("l.ctx.Value error: %v", err)
+		return nil, xcode.NoLogin
+	}
+
+	// 文章不存在或不属于当前用户时由PublishDraft返回错误
+	dret, err := l.svcCtx.ArticleRPC.ArticleDetail(l.ctx, &pb.ArticleDetailRequest{
+		ArticleId: req.ArticleId,
+		ViewerId:  userId,
+	})
+	if err != nil {
+		logx.Errorf("l.svcCtx.ArticleRPC.ArticleDetail articleId: %d userId: %d error: %v", req.ArticleId, userId, err)
+		return nil, err
+	}
+	if article := dret.Article; article != nil {
+		if len(article.Content) < minContentLen {
+			return nil, code.ArticleContentTooFewWords
+		}
+		if len(article.Cover) == 0 {
+			return nil, code.ArticleCoverEmpty
+		}
+	}
+
+	_, err = l.svcCtx.ArticleRPC.PublishDraft(l.ctx, &pb.PublishDraftRequest{
+		UserId:      userId,
+		ArticleId:   req.ArticleId,
+		PublishTime: req.PublishTime,
+	})
+	if err != nil {
+		logx.Errorf("l.svcCtx.ArticleRPC.PublishDraft articleId: %d userId: %d error: %v", req.ArticleId, userId, err)
+		return nil, err
+	}
+
+	return &types.PublishDraftResponse{}, nil
+}
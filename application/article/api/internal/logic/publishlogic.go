@@ -33,15 +33,18 @@ func (l *PublishLogic) Publish(req *types.PublishRequest) (resp *types.PublishRe
 	if len(req.Title) == 0 {
 		return nil, code.ArtitleTitleEmpty
 	}
-	if len(req.Content) < minContentLen {
-		return nil, code.ArticleContentTooFewWords
-	}
-	if len(req.Cover) == 0 {
-		return nil, code.ArticleCoverEmpty
+	// 草稿在发布时再检查内容和封面
+	if !req.Draft {
+		if len(req.Content) < minContentLen {
+			return nil, code.ArticleContentTooFewWords
+		}
+		if len(req.Cover) == 0 {
+			return nil, code.ArticleCoverEmpty
+		}
 	}
 
 	//获取userId
-	userId, err := l.ctx.Value("userId").(json.Number).Int64()
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
 	if err != nil {
 		logx.Errorf("l.ctx.Value error: %v", err)
 		return nil, xcode.NoLogin
@@ -53,6 +56,8 @@ func (l *PublishLogic) Publish(req *types.PublishRequest) (resp *types.PublishRe
 		Content:     req.Content,
 		Description: req.Description,
 		Cover:       req.Cover,
+		Draft:       req.Draft,
+		PublishTime: req.PublishTime,
 	})
 	if err != nil {
 		logx.Errorf("l.svcCtx.ArticleRPC.Publish req: %v userId: %d error: %v", req, userId, err)
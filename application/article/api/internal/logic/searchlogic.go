@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"myBeyond/application/article/api/internal/code"
+	"myBeyond/application/article/api/internal/svc"
+	"myBeyond/application/article/api/internal/types"
+	"myBeyond/application/article/rpc/types/pb"
+	"myBeyond/pkg/xcode"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SearchLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSearchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchLogic {
+	return &SearchLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Search 全文搜索文章，author_id为当前用户时可以按状态搜索自己未通过审核的文章
+func (l *SearchLogic) Search(req *types.SearchRequest) (resp *types.SearchResponse, err error) {
+	userId, err := l.ctx.Value(types.UserIdKey).(json.Number).Int64()
+	if err != nil {
+		logx.Errorf("l.ctx.Value error: %v", err)
+		return nil, xcode.NoLogin
+	}
+	statuses, err := parseStatuses(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	sret, err := l.svcCtx.ArticleRPC.Search(l.ctx, &pb.SearchRequest{
+		Keyword:  req.Keyword,
+		AuthorId: req.AuthorId,
+		Statuses: statuses,
+		SortType: req.Sort,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		ViewerId: userId,
+	})
+	if err != nil {
+		logx.Errorf("l.svcCtx.ArticleRPC.Search req: %v userId: %d error: %v", req, userId, err)
+		return nil, err
+	}
+
+	articles := make([]types.SearchItem, 0, len(sret.Articles))
+	for _, a := range sret.Articles {
+		articles = append(articles, types.SearchItem{
+			ArticleId:      a.ArticleId,
+			Title:          a.Title,
+			TitleHighlight: a.TitleHighlight,
+			Description:    a.Description,
+			Cover:          a.Cover,
+			AuthorId:       a.AuthorId,
+			AuthorName:     a.AuthorName,
+			LikeCount:      a.LikeCount,
+			PublishTime:    a.PublishTime,
+			Snippets:       a.Snippets,
+		})
+	}
+
+	return &types.SearchResponse{
+		Articles: articles,
+		Total:    sret.Total,
+		IsEnd:    sret.IsEnd,
+	}, nil
+}
+
+// parseStatuses 解析逗号分隔的文章状态
+func parseStatuses(s string) ([]int32, error) {
+	if len(strings.TrimSpace(s)) == 0 {
+		return nil, nil
+	}
+
+	var statuses []int32
+	for _, v := range strings.Split(s, ",") {
+		status, err := strconv.ParseInt(strings.TrimSpace(v), 10, 32)
+		if err != nil {
+			return nil, code.SearchStatusInvalid
+		}
+		statuses = append(statuses, int32(status))
+	}
+	return statuses, nil
+}
@@ -2,9 +2,16 @@ package svc
 
 import (
 	"myBeyond/application/article/api/internal/config"
+	"myBeyond/application/article/api/internal/types"
 	"myBeyond/application/article/rpc/article"
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/middleware"
+	"myBeyond/pkg/session"
+	"myBeyond/pkg/signature"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
@@ -14,9 +21,14 @@ const (
 )
 
 type ServiceContext struct {
-	Config config.Config
-	OssClient  *oss.Client
-	ArticleRPC article.Article
+	Config      config.Config
+	OssClient   *oss.Client
+	ArticleRPC  article.Article
+	BizRedis    *redis.Redis
+	Signature   rest.Middleware
+	JwtAuth     rest.Middleware
+	TokenRevoke rest.Middleware
+	Session     rest.Middleware
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -32,9 +44,17 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		panic(err)
 	}
 
+	rds := redis.MustNewRedis(c.BizRedis)
+
 	return &ServiceContext{
-		Config:     c,
-		OssClient:  oc,
-		ArticleRPC: article.NewArticle(zrpc.MustNewClient(c.ArticleRPC)),
+		Config:      c,
+		OssClient:   oc,
+		ArticleRPC:  article.NewArticle(zrpc.MustNewClient(c.ArticleRPC)),
+		BizRedis:    rds,
+		Signature:   middleware.NewSignatureMiddleware(signature.NewVerifier(c.RequestSign, signature.NewRedisNonceStore(rds))).Handle,
+		JwtAuth:     middleware.NewJwtAuthMiddleware(jwt.MustNewAccessKeyring(c.Auth.AccessSecret, c.Auth.AccessKeys)).Handle,
+		TokenRevoke: middleware.NewTokenRevokeMiddleware(jwt.NewRevokeStore(rds), types.UserIdKey).Handle,
+		// 会话由applet-api维护，这里只校验是否已经下线
+		Session: middleware.NewSessionMiddleware(session.NewStore(rds, 0), types.UserIdKey).Handle,
 	}
 }
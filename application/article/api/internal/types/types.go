@@ -10,8 +10,78 @@ type PublishRequest struct {
 	Content     string `json:"content"`
 	Description string `json:"description"`
 	Cover       string `json:"cover"`
+	Draft       bool   `json:"draft,optional"`
+	PublishTime int64  `json:"publish_time,optional"`
 }
 
 type PublishResponse struct {
 	ArticleId int64 `json:"article_id"`
 }
+
+type ArticleUpdateRequest struct {
+	ArticleId   int64  `path:"id"`
+	Title       string `json:"title"`
+	Content     string `json:"content"`
+	Description string `json:"description"`
+	Cover       string `json:"cover"`
+}
+
+type ArticleUpdateResponse struct {
+}
+
+type DraftsRequest struct {
+	Cursor   int64 `form:"cursor,optional"`
+	PageSize int64 `form:"page_size,optional"`
+}
+
+type DraftItem struct {
+	ArticleId   int64  `json:"article_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Cover       string `json:"cover"`
+	Status      int32  `json:"status"`
+	PublishTime int64  `json:"publish_time"`
+	UpdateTime  int64  `json:"update_time"`
+}
+
+type DraftsResponse struct {
+	Drafts []DraftItem `json:"drafts"`
+	IsEnd  bool        `json:"is_end"`
+	Cursor int64       `json:"cursor"`
+}
+
+type PublishDraftRequest struct {
+	ArticleId   int64 `path:"id"`
+	PublishTime int64 `json:"publish_time,optional"`
+}
+
+type PublishDraftResponse struct {
+}
+
+type SearchRequest struct {
+	Keyword  string `form:"keyword"`
+	AuthorId int64  `form:"author_id,optional"`
+	Status   string `form:"status,optional"` // 搜索自己的文章时可以指定，多个状态用逗号分隔
+	Sort     int32  `form:"sort,optional"`   // 0:相关度 1:点赞数 2:发布时间
+	Page     int64  `form:"page,optional"`
+	PageSize int64  `form:"page_size,optional"`
+}
+
+type SearchItem struct {
+	ArticleId      int64    `json:"article_id"`
+	Title          string   `json:"title"`
+	TitleHighlight string   `json:"title_highlight"`
+	Description    string   `json:"description"`
+	Cover          string   `json:"cover"`
+	AuthorId       int64    `json:"author_id"`
+	AuthorName     string   `json:"author_name"`
+	LikeCount      int64    `json:"like_count"`
+	PublishTime    int64    `json:"publish_time"`
+	Snippets       []string `json:"snippets"`
+}
+
+type SearchResponse struct {
+	Articles []SearchItem `json:"articles"`
+	Total    int64        `json:"total"`
+	IsEnd    bool         `json:"is_end"`
+}
@@ -9,6 +9,7 @@ import (
 type Config struct {
 	KqConsumerConf        kq.KqConf
 	ArticleKqConsumerConf kq.KqConf
+	AuditKqConsumerConf   kq.KqConf
 	Datasource            string
 	BizRedis              redis.RedisConf
 	// es config
@@ -18,4 +19,6 @@ type Config struct {
 		Password  string
 	}
 	UserRPC zrpc.RpcClientConf
+	// 审核结果通知作者使用的模板，邮件和短信模板使用相同的ID
+	AuditNotifyTemplate string `json:",default=article_audit"`
 }
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"myBeyond/application/article/mq/internal/svc"
+	"myBeyond/application/article/mq/internal/types"
+	"myBeyond/application/user/rpc/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ArticleAuditLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewArticleAuditLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ArticleAuditLogic {
+	return &ArticleAuditLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Consume 把审核结果通知作者，通知失败只记录日志，不阻塞后续消息
+func (l *ArticleAuditLogic) Consume(_, val string) error {
+	var msg *types.ArticleAuditMsg
+	err := json.Unmarshal([]byte(val), &msg)
+	if err != nil {
+		logx.Errorf("Consume val: %s error: %v", val, err)
+		return err
+	}
+
+	result := "已审核通过"
+	if msg.Status == types.ArticleStatusNotPass {
+		result = "审核不通过"
+	}
+	ret, err := l.svcCtx.UserRPC.NotifyUser(l.ctx, &user.NotifyUserRequest{
+		UserId:     msg.AuthorId,
+		TemplateId: l.svcCtx.Config.AuditNotifyTemplate,
+		Params: map[string]string{
+			"title":  msg.Title,
+			"result": result,
+			"reason": msg.Reason,
+		},
+	})
+	if err != nil {
+		l.Logger.Errorf("NotifyUser articleId: %d authorId: %d error: %v", msg.ArticleId, msg.AuthorId, err)
+		return nil
+	}
+	l.Logger.Infof("notified audit result articleId: %d authorId: %d channel: %s", msg.ArticleId, msg.AuthorId, ret.Channel)
+
+	return nil
+}
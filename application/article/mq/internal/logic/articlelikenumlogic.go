@@ -70,5 +70,6 @@ func Consumers(ctx context.Context, svcCtx *svc.ServiceContext) []service.Servic
 	return []service.Service{
 		kq.MustNewQueue(svcCtx.Config.KqConsumerConf, NewArticleLikeNumLogic(ctx, svcCtx)),
 		kq.MustNewQueue(svcCtx.Config.ArticleKqConsumerConf, NewArticleLogic(ctx, svcCtx)),
+		kq.MustNewQueue(svcCtx.Config.AuditKqConsumerConf, NewArticleAuditLogic(ctx, svcCtx)),
 	}
 }
@@ -17,6 +17,9 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// 与user rpc中FindByIds的单次上限保持一致
+const maxFindByIds = 100
+
 type ArticleLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -59,8 +62,9 @@ func (l *ArticleLogic) articleOperate(ctx context.Context, msg *types.CanalArtic
 		publishTimeKey := articlesKey(d.AuthorId, 0)
 		likeNumKey := articlesKey(d.AuthorId, 1)
 
+		// 审核通过后才写入作者的文章列表，修改后重新审核、审核不通过和删除时移除
 		switch status {
-		case types.ArticleStatusPending:
+		case types.ArticleStatusVisible:
 			b, _ := l.svcCtx.BizRedis.ExistsCtx(ctx, publishTimeKey)
 			if b {
 				_, err = l.svcCtx.BizRedis.ZaddCtx(ctx, publishTimeKey, t.Unix(), d.ID)
@@ -76,7 +80,7 @@ func (l *ArticleLogic) articleOperate(ctx context.Context, msg *types.CanalArtic
 					l.Logger.Errorf("ZaddCtx key: %s req: %v error: %v", likeNumKey, d, err)
 				}
 			}
-		case types.ArticleStatusUserDelete:
+		case types.ArticleStatusPending, types.ArticleStatusNotPass, types.ArticleStatusUserDelete:
 			_, err = l.svcCtx.BizRedis.ZremCtx(ctx, publishTimeKey, d.ID)
 			if err != nil {
 				logx.Errorf("ZremCtx key: %s req: %v error: %v", publishTimeKey, d, err)
@@ -88,33 +92,64 @@ func (l *ArticleLogic) articleOperate(ctx context.Context, msg *types.CanalArtic
 			}
 		}
 
-		u, err := l.svcCtx.UserRPC.FindById(l.ctx, &user.FindByIdRequest{
-			UserId: authorId,
-		})
-		if err != nil {
-			l.Logger.Errorf("FindById userId: %d error: %v", authorId, err)
-			return err
-		}
-
 		esData = append(esData, &types.ArticleEsMsg{
 			ArticleId:   articleId,
 			AuthorId:    authorId,
-			AuthorName:  u.Username,
 			Title:       d.Title,
 			Content:     d.Content,
 			Description: d.Description,
+			Cover:       d.Cover,
 			Status:      status,
 			LikeNum:     likNum,
+			PublishTime: d.PublishTime,
 		})
 	}
 
-	err := l.BatchUpSertToEs(l.ctx, esData)
+	// 批量查询作者信息，填充作者名
+	authorIds := make([]int64, 0, len(esData))
+	for _, d := range esData {
+		authorIds = append(authorIds, d.AuthorId)
+	}
+	users, err := l.findUsers(ctx, authorIds)
+	if err != nil {
+		return err
+	}
+	for _, d := range esData {
+		if u, ok := users[d.AuthorId]; ok {
+			d.AuthorName = u.Username
+		}
+	}
+
+	err = l.BatchUpSertToEs(l.ctx, esData)
 	if err != nil {
 		l.Logger.Errorf("BatchUpSertToEs data: %v error: %v", esData, err)
 	}
 	return err
 }
 
+// findUsers 按FindByIds的上限分批查询用户
+func (l *ArticleLogic) findUsers(ctx context.Context, userIds []int64) (map[int64]*user.UserItem, error) {
+	users := make(map[int64]*user.UserItem, len(userIds))
+	for start := 0; start < len(userIds); start += maxFindByIds {
+		end := start + maxFindByIds
+		if end > len(userIds) {
+			end = len(userIds)
+		}
+		resp, err := l.svcCtx.UserRPC.FindByIds(ctx, &user.FindByIdsRequest{
+			UserIds: userIds[start:end],
+		})
+		if err != nil {
+			l.Logger.Errorf("FindByIds userIds: %v error: %v", userIds[start:end], err)
+			return nil, err
+		}
+		for id, u := range resp.Users {
+			users[id] = u
+		}
+	}
+
+	return users, nil
+}
+
 func (l *ArticleLogic) BatchUpSertToEs(ctx context.Context, data []*types.ArticleEsMsg) error {
 	if len(data) == 0 {
 		return nil
@@ -29,6 +29,7 @@ type CanalArticleMsg struct {
 		Title       string `json:"title"`
 		Content     string `json:"content"`
 		Description string `json:"description"`
+		Cover       string `json:"cover"`
 		AuthorId    string `json:"author_id"`
 		Status      string `json:"status"`
 		CommentNum  string `json:"comment_num"`
@@ -48,6 +49,7 @@ type ArticleEsMsg struct {
 	Title       string  `json:"title"`
 	Content     string  `json:"content"`
 	Description string  `json:"description"`
+	Cover       string  `json:"cover"`
 	AuthorId    int64   `json:"author_id"`
 	AuthorName  string  `json:"author_name"`
 	Status      int     `json:"status"`
@@ -61,3 +63,14 @@ type ArticleEsMsg struct {
 	CreateTime  string  `json:"create_time"`
 	UpdateTime  string  `json:"update_time"`
 }
+
+// ArticleAuditMsg 文章审核结果消息，由article rpc发送
+type ArticleAuditMsg struct {
+	ArticleId  int64  `json:"articleId,omitempty"`
+	AuthorId   int64  `json:"authorId,omitempty"`
+	Title      string `json:"title,omitempty"`
+	Status     int    `json:"status,omitempty"` // 审核结果 1:审核不通过 2:可见
+	Reason     string `json:"reason,omitempty"`
+	AuditTime  int64  `json:"auditTime,omitempty"`
+	OperatorId int64  `json:"operatorId,omitempty"`
+}
@@ -11,4 +11,8 @@ const (
 	ArticleStatusVisible
 	// ArticleStatusUserDelete 用户删除
 	ArticleStatusUserDelete
+	// ArticleStatusDraft 草稿
+	ArticleStatusDraft
+	// ArticleStatusScheduled 定时发布，到publish_time后由cmd/scheduler改为待审核
+	ArticleStatusScheduled
 )
@@ -24,6 +24,8 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
+	// 停止敏感词库的定时重新加载
+	defer ctx.Sensitive.Close()
 
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterArticleServer(grpcServer, server.NewArticleServer(ctx))
@@ -13,21 +13,54 @@ import (
 )
 
 type (
-	ArticleDeleteRequest  = pb.ArticleDeleteRequest
-	ArticleDeleteResponse = pb.ArticleDeleteResponse
-	ArticleDetailRequest  = pb.ArticleDetailRequest
-	ArticleDetailResponse = pb.ArticleDetailResponse
-	ArticleItem           = pb.ArticleItem
-	ArticlesRequest       = pb.ArticlesRequest
-	ArticlesResponse      = pb.ArticlesResponse
-	PublishRequest        = pb.PublishRequest
-	PublishResponse       = pb.PublishResponse
+	ApproveArticleRequest      = pb.ApproveArticleRequest
+	ApproveArticleResponse     = pb.ApproveArticleResponse
+	ArticleDeleteRequest       = pb.ArticleDeleteRequest
+	ArticleDeleteResponse      = pb.ArticleDeleteResponse
+	ArticleDetailRequest       = pb.ArticleDetailRequest
+	ArticleDetailResponse      = pb.ArticleDetailResponse
+	ArticleItem                = pb.ArticleItem
+	ArticleRevisionsRequest    = pb.ArticleRevisionsRequest
+	ArticleRevisionsResponse   = pb.ArticleRevisionsResponse
+	ArticleUpdateRequest       = pb.ArticleUpdateRequest
+	ArticleUpdateResponse      = pb.ArticleUpdateResponse
+	ArticlesRequest            = pb.ArticlesRequest
+	ArticlesResponse           = pb.ArticlesResponse
+	DeleteUserArticlesRequest  = pb.DeleteUserArticlesRequest
+	DeleteUserArticlesResponse = pb.DeleteUserArticlesResponse
+	DraftItem                  = pb.DraftItem
+	DraftsRequest              = pb.DraftsRequest
+	DraftsResponse             = pb.DraftsResponse
+	ModerationQueueRequest     = pb.ModerationQueueRequest
+	ModerationQueueResponse    = pb.ModerationQueueResponse
+	PublishDraftRequest        = pb.PublishDraftRequest
+	PublishDraftResponse       = pb.PublishDraftResponse
+	PublishRequest             = pb.PublishRequest
+	PublishResponse            = pb.PublishResponse
+	RejectArticleRequest       = pb.RejectArticleRequest
+	RejectArticleResponse      = pb.RejectArticleResponse
+	RestoreRevisionRequest     = pb.RestoreRevisionRequest
+	RestoreRevisionResponse    = pb.RestoreRevisionResponse
+	RevisionItem               = pb.RevisionItem
+	SearchItem                 = pb.SearchItem
+	SearchRequest              = pb.SearchRequest
+	SearchResponse             = pb.SearchResponse
 
 	Article interface {
 		Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
 		Articles(ctx context.Context, in *ArticlesRequest, opts ...grpc.CallOption) (*ArticlesResponse, error)
 		ArticleDelete(ctx context.Context, in *ArticleDeleteRequest, opts ...grpc.CallOption) (*ArticleDeleteResponse, error)
 		ArticleDetail(ctx context.Context, in *ArticleDetailRequest, opts ...grpc.CallOption) (*ArticleDetailResponse, error)
+		DeleteUserArticles(ctx context.Context, in *DeleteUserArticlesRequest, opts ...grpc.CallOption) (*DeleteUserArticlesResponse, error)
+		ArticleUpdate(ctx context.Context, in *ArticleUpdateRequest, opts ...grpc.CallOption) (*ArticleUpdateResponse, error)
+		ArticleRevisions(ctx context.Context, in *ArticleRevisionsRequest, opts ...grpc.CallOption) (*ArticleRevisionsResponse, error)
+		RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error)
+		Drafts(ctx context.Context, in *DraftsRequest, opts ...grpc.CallOption) (*DraftsResponse, error)
+		PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error)
+		ApproveArticle(ctx context.Context, in *ApproveArticleRequest, opts ...grpc.CallOption) (*ApproveArticleResponse, error)
+		RejectArticle(ctx context.Context, in *RejectArticleRequest, opts ...grpc.CallOption) (*RejectArticleResponse, error)
+		ModerationQueue(ctx context.Context, in *ModerationQueueRequest, opts ...grpc.CallOption) (*ModerationQueueResponse, error)
+		Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 	}
 
 	defaultArticle struct {
@@ -60,3 +93,53 @@ func (m *defaultArticle) ArticleDetail(ctx context.Context, in *ArticleDetailReq
 	client := pb.NewArticleClient(m.cli.Conn())
 	return client.ArticleDetail(ctx, in, opts...)
 }
+
+func (m *defaultArticle) DeleteUserArticles(ctx context.Context, in *DeleteUserArticlesRequest, opts ...grpc.CallOption) (*DeleteUserArticlesResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.DeleteUserArticles(ctx, in, opts...)
+}
+
+func (m *defaultArticle) ArticleUpdate(ctx context.Context, in *ArticleUpdateRequest, opts ...grpc.CallOption) (*ArticleUpdateResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.ArticleUpdate(ctx, in, opts...)
+}
+
+func (m *defaultArticle) ArticleRevisions(ctx context.Context, in *ArticleRevisionsRequest, opts ...grpc.CallOption) (*ArticleRevisionsResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.ArticleRevisions(ctx, in, opts...)
+}
+
+func (m *defaultArticle) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.RestoreRevision(ctx, in, opts...)
+}
+
+func (m *defaultArticle) Drafts(ctx context.Context, in *DraftsRequest, opts ...grpc.CallOption) (*DraftsResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.Drafts(ctx, in, opts...)
+}
+
+func (m *defaultArticle) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.PublishDraft(ctx, in, opts...)
+}
+
+func (m *defaultArticle) ApproveArticle(ctx context.Context, in *ApproveArticleRequest, opts ...grpc.CallOption) (*ApproveArticleResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.ApproveArticle(ctx, in, opts...)
+}
+
+func (m *defaultArticle) RejectArticle(ctx context.Context, in *RejectArticleRequest, opts ...grpc.CallOption) (*RejectArticleResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.RejectArticle(ctx, in, opts...)
+}
+
+func (m *defaultArticle) ModerationQueue(ctx context.Context, in *ModerationQueueRequest, opts ...grpc.CallOption) (*ModerationQueueResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.ModerationQueue(ctx, in, opts...)
+}
+
+func (m *defaultArticle) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	client := pb.NewArticleClient(m.cli.Conn())
+	return client.Search(ctx, in, opts...)
+}
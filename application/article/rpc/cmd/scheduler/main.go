@@ -0,0 +1,83 @@
+// scheduler 把到了发布时间的定时文章改为待审核，和立即发布的文章一样审核通过后才对读者可见
+// 修改状态时比较原状态，多个实例同时运行也不会重复发布
+//
+//	go run ./cmd/scheduler -f etc/article.yaml
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"myBeyond/application/article/rpc/internal/config"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/types"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var (
+	configFile = flag.String("f", "etc/article.yaml", "the config file")
+	batchSize  = flag.Int("batch", 100, "number of scheduled articles to publish per round")
+	interval   = flag.Duration("interval", 10*time.Second, "interval between rounds")
+	once       = flag.Bool("once", false, "publish due articles once and exit")
+)
+
+func main() {
+	flag.Parse()
+	if *batchSize <= 0 {
+		logx.Must(fmt.Errorf("invalid batch size: %d", *batchSize))
+	}
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	articleModel := model.NewArticleModel(sqlx.NewMysql(c.DataSource), c.CacheRedis)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	for {
+		due, failed := runOnce(ctx, articleModel)
+		if *once {
+			return
+		}
+		// 还有积压时立即处理下一批
+		if due == *batchSize && failed == 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(*interval):
+		}
+	}
+}
+
+func runOnce(ctx context.Context, articleModel model.ArticleModel) (due, failed int) {
+	list, err := articleModel.FindDue(ctx, types.ArticleStatusScheduled, time.Now(), *batchSize)
+	if err != nil {
+		logx.Errorf("FindDue error: %v", err)
+		return 0, 0
+	}
+
+	for _, a := range list {
+		// 保留设置的发布时间，文章列表按发布时间排序
+		_, err = articleModel.CompareAndSetStatus(ctx, a.Id,
+			types.ArticleStatusScheduled, types.ArticleStatusPending, a.PublishTime)
+		if err != nil {
+			logx.Errorf("CompareAndSetStatus articleId: %d error: %v", a.Id, err)
+			failed++
+		}
+	}
+	if len(list) > 0 {
+		logx.Infof("published scheduled articles: %d, failed: %d", len(list)-failed, failed)
+	}
+
+	return len(list), failed
+}
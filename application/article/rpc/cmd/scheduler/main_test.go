@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/types"
+)
+
+type fakeArticleModel struct {
+	model.ArticleModel
+	articles map[int64]*model.Article
+	updates  int
+}
+
+func (m *fakeArticleModel) FindDue(ctx context.Context, status int, now time.Time, limit int) ([]*model.Article, error) {
+	var ret []*model.Article
+	for _, a := range m.articles {
+		if a.Status == int64(status) && !a.PublishTime.After(now) && len(ret) < limit {
+			article := *a
+			ret = append(ret, &article)
+		}
+	}
+	return ret, nil
+}
+
+func (m *fakeArticleModel) CompareAndSetStatus(ctx context.Context, id int64, from, to int, publishTime time.Time) (bool, error) {
+	a, ok := m.articles[id]
+	if !ok || a.Status != int64(from) {
+		return false, nil
+	}
+	a.Status, a.PublishTime = int64(to), publishTime
+	m.updates++
+	return true, nil
+}
+
+func TestRunOnce(t *testing.T) {
+	due := time.Now().Add(-time.Minute).Truncate(time.Second)
+	later := time.Now().Add(time.Hour)
+	articles := &fakeArticleModel{articles: map[int64]*model.Article{
+		1: {Id: 1, Status: types.ArticleStatusScheduled, PublishTime: due},
+		2: {Id: 2, Status: types.ArticleStatusScheduled, PublishTime: later},
+		3: {Id: 3, Status: types.ArticleStatusDraft, PublishTime: due},
+	}}
+
+	n, failed := runOnce(context.Background(), articles)
+	if n != 1 || failed != 0 {
+		t.Fatalf("due = %d, failed = %d", n, failed)
+	}
+	// 到期的定时文章改为待审核，保留设置的发布时间
+	if a := articles.articles[1]; a.Status != types.ArticleStatusPending || !a.PublishTime.Equal(due) {
+		t.Errorf("article 1 = %+v", a)
+	}
+	if a := articles.articles[2]; a.Status != types.ArticleStatusScheduled {
+		t.Errorf("article 2 status = %d", a.Status)
+	}
+	if a := articles.articles[3]; a.Status != types.ArticleStatusDraft {
+		t.Errorf("article 3 status = %d", a.Status)
+	}
+
+	// 再次运行不会重复发布
+	if n, _ = runOnce(context.Background(), articles); n != 0 {
+		t.Errorf("second run due = %d", n)
+	}
+	if articles.updates != 1 {
+		t.Errorf("updates = %d", articles.updates)
+	}
+}
+
+// 查询之后文章被作者发布或删除，按原状态修改不会覆盖
+func TestRunOnceStatusChanged(t *testing.T) {
+	articles := &fakeArticleModel{articles: map[int64]*model.Article{
+		1: {Id: 1, Status: types.ArticleStatusScheduled, PublishTime: time.Now().Add(-time.Minute)},
+	}}
+	stale := &staleArticleModel{fakeArticleModel: articles, status: types.ArticleStatusUserDelete}
+
+	if _, failed := runOnce(context.Background(), stale); failed != 0 {
+		t.Fatalf("failed = %d", failed)
+	}
+	if a := articles.articles[1]; a.Status != types.ArticleStatusUserDelete {
+		t.Errorf("status = %d", a.Status)
+	}
+	if articles.updates != 0 {
+		t.Errorf("updates = %d", articles.updates)
+	}
+}
+
+// staleArticleModel 在FindDue返回之后修改文章状态，模拟并发的修改
+type staleArticleModel struct {
+	*fakeArticleModel
+	status int64
+}
+
+func (m *staleArticleModel) FindDue(ctx context.Context, status int, now time.Time, limit int) ([]*model.Article, error) {
+	list, err := m.fakeArticleModel.FindDue(ctx, status, now, limit)
+	for _, a := range list {
+		m.articles[a.Id].Status = m.status
+	}
+	return list, err
+}
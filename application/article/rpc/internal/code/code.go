@@ -11,4 +11,13 @@ var (
 	ArticleContentCantEmpty = xcode.New(60004, "文章内容不能为空") // 文章内容不能为空
 	ArticleIdInvalid        = xcode.New(60005, "文章ID无效")   // 文章ID无效
 	ArticleIdNotExist       = xcode.New(60005, "文章ID不存在")  // 文章ID无效
+	RevisionNotExist        = xcode.New(60006, "修改记录不存在")  // 修改记录不存在或不属于该文章
+	PublishTimeInvalid      = xcode.New(60007, "发布时间无效")   // 定时发布的时间超出允许的范围
+	ArticleNotDraft         = xcode.New(60008, "文章不是草稿")   // 只有草稿和定时发布的文章可以发布
+	ArticleCantAudit        = xcode.New(60009, "文章状态不能审核") // 文章状态不允许改为审核结果
+	AuditReasonInvalid      = xcode.New(60010, "审核原因无效")   // 审核不通过时原因为空或过长
+	ArticleStatusInvalid    = xcode.New(60011, "文章状态无效")   // 查询的文章状态不在允许的范围内
+	ArticleHasSensitiveWord = xcode.New(60012, "文章包含敏感词")  // 标题、描述或内容命中敏感词
+	SearchKeywordInvalid    = xcode.New(60013, "搜索关键词无效")  // 关键词为空或过长
+	SearchPageInvalid       = xcode.New(60014, "搜索页码无效")   // 页码小于1或超出可以查询的范围
 )
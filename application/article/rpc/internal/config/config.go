@@ -1,6 +1,8 @@
 package config
 
 import (
+	"myBeyond/pkg/sensitive"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -11,4 +13,21 @@ type Config struct {
 	DataSource string
 	CacheRedis cache.CacheConf
 	BizRedis   redis.RedisConf
+	FollowRPC  zrpc.RpcClientConf // 查询拉黑关系
+	// 审核结果通知，由article mq消费后通知作者
+	KqPusherConf struct {
+		Brokers []string
+		Topic   string
+	}
+	// 发布时检查敏感词，未配置词库时不检查
+	Sensitive sensitive.Conf `json:",optional"`
+	// reject:拒绝发布 audit:保存为审核不通过并记录命中的词
+	SensitiveMode string `json:",default=reject,options=reject|audit"`
+	// 搜索使用的es，索引由article mq同步
+	Es struct {
+		Addresses []string
+		Username  string
+		Password  string
+		Index     string `json:",default=article-index"`
+	}
 }
@@ -0,0 +1,36 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ApproveArticleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewApproveArticleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApproveArticleLogic {
+	return &ApproveArticleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ApproveArticleLogic) ApproveArticle(in *pb.ApproveArticleRequest) (*pb.ApproveArticleResponse, error) {
+	// 待审核和审核不通过的文章可以改为可见，审核不通过后作者修改了内容可以重新审核
+	err := auditArticle(l.ctx, l.svcCtx, in.OperatorId, in.ArticleId,
+		[]int{types.ArticleStatusPending, types.ArticleStatusNotPass}, types.ArticleStatusVisible, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveArticleResponse{}, nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 
+	"myBeyond/application/article/rpc/internal/model"
 	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
 	"myBeyond/application/article/rpc/types/pb"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -35,7 +37,21 @@ func (l *ArticleDetailLogic) ArticleDetail(in *pb.ArticleDetailRequest) (*pb.Art
 		}
 		return nil, err
 	}
-	return &pb.ArticleDetailResponse{
+	// 3、与作者存在拉黑关系时按文章不存在处理
+	blocked, err := isAuthorBlocked(l.ctx, l.svcCtx, in.ViewerId, article.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &pb.ArticleDetailResponse{}, nil
+	}
+	// 4、读者只能看到审核通过的文章，作者可以看到自己未删除的文章
+	isAuthor := article.AuthorId == in.ViewerId
+	if article.Status == types.ArticleStatusUserDelete ||
+		(!isAuthor && article.Status != types.ArticleStatusVisible) {
+		return &pb.ArticleDetailResponse{}, nil
+	}
+	resp := &pb.ArticleDetailResponse{
 		Article: &pb.ArticleItem{
 			Id:          article.Id,
 			Title:       article.Title,
@@ -46,5 +62,22 @@ func (l *ArticleDetailLogic) ArticleDetail(in *pb.ArticleDetailRequest) (*pb.Art
 			LikeCount:   article.LikeNum,
 			PublishTime: article.PublishTime.Unix(),
 		},
-	}, nil
+	}
+	if !isAuthor {
+		return resp, nil
+	}
+
+	// 5、作者查看时返回状态和审核不通过的原因
+	resp.Article.Status = int32(article.Status)
+	if article.Status == types.ArticleStatusNotPass {
+		audit, err := l.svcCtx.AuditModel.FindLatestByArticleId(l.ctx, article.Id)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			l.Logger.Errorf("FindLatestByArticleId articleId: %d error: %v", article.Id, err)
+		}
+		if audit != nil {
+			resp.AuditReason = audit.Reason
+		}
+	}
+
+	return resp, nil
 }
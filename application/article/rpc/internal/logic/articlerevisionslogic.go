@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ArticleRevisionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewArticleRevisionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ArticleRevisionsLogic {
+	return &ArticleRevisionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ArticleRevisionsLogic) ArticleRevisions(in *pb.ArticleRevisionsRequest) (*pb.ArticleRevisionsResponse, error) {
+	// 1、检查参数，只有作者可以查看
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultRevisionPageSize
+	}
+	if in.PageSize > types.MaxRevisionPageSize {
+		in.PageSize = types.MaxRevisionPageSize
+	}
+	if _, err := findAuthorArticle(l.ctx, l.svcCtx, in.UserId, in.ArticleId); err != nil {
+		return nil, err
+	}
+
+	// 2、多查一条判断是否还有下一页
+	revisions, err := l.svcCtx.RevisionModel.FindByArticleId(l.ctx, in.ArticleId, in.Cursor, int(in.PageSize)+1)
+	if err != nil {
+		l.Logger.Errorf("FindByArticleId req: %v error: %v", in, err)
+		return nil, err
+	}
+	isEnd := len(revisions) <= int(in.PageSize)
+	if !isEnd {
+		revisions = revisions[:in.PageSize]
+	}
+
+	items := make([]*pb.RevisionItem, 0, len(revisions))
+	for _, r := range revisions {
+		items = append(items, &pb.RevisionItem{
+			RevisionId:  r.Id,
+			Title:       r.Title,
+			Content:     r.Content,
+			Description: r.Description,
+			Cover:       r.Cover,
+			CreateTime:  r.CreateTime.Unix(),
+		})
+	}
+	var cursor int64
+	if len(items) > 0 {
+		cursor = items[len(items)-1].RevisionId
+	}
+
+	return &pb.ArticleRevisionsResponse{
+		Revisions: items,
+		IsEnd:     isEnd,
+		Cursor:    cursor,
+	}, nil
+}
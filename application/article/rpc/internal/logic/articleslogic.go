@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -23,6 +24,13 @@ const (
 	articlesExpire = 3600 * 24 * 2
 )
 
+var (
+	// visibleStatuses 读者只能看到审核通过的文章，缓存的文章列表也只包含这些文章
+	visibleStatuses = []int{types.ArticleStatusVisible}
+	// authorStatuses 作者查看自己的文章时还可以看到待审核和审核不通过的文章
+	authorStatuses = []int{types.ArticleStatusPending, types.ArticleStatusNotPass, types.ArticleStatusVisible}
+)
+
 type ArticlesLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -49,30 +57,46 @@ func (l *ArticlesLogic) Articles(in *pb.ArticlesRequest) (*pb.ArticlesResponse,
 	if in.PageSize == 0 {
 		in.PageSize = types.DefaultPageSize
 	}
+	blocked, err := isAuthorBlocked(l.ctx, l.svcCtx, in.ViewerId, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &pb.ArticlesResponse{IsEnd: true}, nil
+	}
 
 	var (
-		err       error
 		isEnd     bool
 		cursor    int64 = in.Cursor
 		sortField string
 		curPage   []*pb.ArticleItem
 		articles  []*model.Article
+		cacheIds  []int64
+		// 作者查看自己的文章时直接查数据库，不读写缓存
+		isAuthor = in.ViewerId == in.UserId
+		statuses = visibleStatuses
 	)
+	if isAuthor {
+		statuses = authorStatuses
+	}
 	//2、查找缓存
 	//3.1、判断缓存是否为空并添加结束符
-	cacheIds, err := l.cacheArticles(l.ctx, in.UserId, in.Cursor, in.PageSize, in.SortType)
+	if !isAuthor {
+		cacheIds, err = l.cacheArticles(l.ctx, in.UserId, in.Cursor, in.PageSize, in.SortType)
+	}
 
 	if err != nil {
 		return nil, err
 	}
 	if len(cacheIds) != 0 {
 		//3.2、不为空
-		//3.3、转换对象
-		articles, err = l.articleByIds(l.ctx, cacheIds)
-
+		//3.3、转换对象，去掉缓存中已经不可见的文章并补齐当前页
+		articles, cacheIds, err = l.visibleCacheArticles(l.ctx, in.UserId, in.Cursor, in.PageSize, in.SortType, cacheIds)
 		if err != nil {
 			return nil, err
 		}
+	}
+	if len(cacheIds) != 0 {
 		curPage = l.ArticleItemByArticle(l.ctx, articles)
 
 		//3.4、构造响应参数
@@ -100,7 +124,7 @@ func (l *ArticlesLogic) Articles(in *pb.ArticlesRequest) (*pb.ArticlesResponse,
 	}
 
 	//4.1、查找数据库
-	articles, err = l.svcCtx.ArticleModel.ArticlesByUserId(l.ctx, strconv.Itoa(int(in.UserId)), sortField, int(in.Cursor), types.DefaultLimit)
+	articles, err = l.svcCtx.ArticleModel.ArticlesByUserId(l.ctx, strconv.Itoa(int(in.UserId)), sortField, statuses, int(in.Cursor), types.DefaultLimit)
 	if err != nil {
 		logx.Errorf("ArticlesByUserId userId: %d sortField: %s error: %v", in.UserId, sortField, err)
 		return nil, err
@@ -115,14 +139,16 @@ func (l *ArticlesLogic) Articles(in *pb.ArticlesRequest) (*pb.ArticlesResponse,
 	//4.2、写缓存
 	//threading.GoSafe(
 	wg := &sync.WaitGroup{}
-	wg.Add(1)
-	go func() {
-		err = l.addCacheArticles(l.ctx, articles, in.UserId, in.SortType)
-		if err != nil {
-			logx.Errorf("addCacheArticles error: %v", err)
-		}
-		wg.Done()
-	}()
+	if !isAuthor {
+		wg.Add(1)
+		go func() {
+			err = l.addCacheArticles(l.ctx, articles, in.UserId, in.SortType)
+			if err != nil {
+				logx.Errorf("addCacheArticles error: %v", err)
+			}
+			wg.Done()
+		}()
+	}
 
 	//4.3、转换对象
 	articlesLen := len(articles)
@@ -139,6 +165,11 @@ func (l *ArticlesLogic) Articles(in *pb.ArticlesRequest) (*pb.ArticlesResponse,
 	}
 
 	curPage = l.ArticleItemByArticle(l.ctx, articles)
+	if isAuthor {
+		for i, article := range articles {
+			curPage[i].Status = int32(article.Status)
+		}
+	}
 
 	cursor += int64(len(curPage))
 	fmt.Printf("%d + %d = %d\n", in.Cursor, int64(len(curPage)), cursor)
@@ -210,7 +241,53 @@ func (l *ArticlesLogic) cacheArticles(ctx context.Context, uid, cursor, ps int64
 	return ids, nil
 }
 
-// 根据id转换对象
+// visibleCacheArticles 缓存中可能还有刚被审核不通过或删除的文章，把这些文章从缓存中移除后重新读取当前页，
+// 避免返回不满的页，也避免后续按页计算的偏移量错位
+func (l *ArticlesLogic) visibleCacheArticles(ctx context.Context, uid, cursor, ps int64, sortType int32, ids []int64) ([]*model.Article, []int64, error) {
+	key := articlesKey(uid, sortType)
+	for i := 0; ; i++ {
+		articles, err := l.articleByIds(ctx, ids)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// 1、按缓存中的顺序保留可见的文章，其余的都是过期的id
+		found := make(map[int64]*model.Article, len(articles))
+		for _, article := range articles {
+			found[article.Id] = article
+		}
+		visible := make([]*model.Article, 0, len(ids))
+		var stale []any
+		for _, id := range ids {
+			if id == -1 {
+				continue
+			}
+			if article, ok := found[id]; ok && article.Status == types.ArticleStatusVisible {
+				visible = append(visible, article)
+			} else {
+				stale = append(stale, strconv.FormatInt(id, 10))
+			}
+		}
+		if len(stale) == 0 || i == types.MaxArticlesRefill {
+			return visible, ids, nil
+		}
+
+		// 2、移除过期的id后重新读取当前页
+		if _, err = l.svcCtx.BizRedis.ZremCtx(ctx, key, stale...); err != nil {
+			logx.Errorf("ZremCtx key: %s ids: %v error: %v", key, stale, err)
+			return visible, ids, nil
+		}
+		ids, err = l.cacheArticles(ctx, uid, cursor, ps, sortType)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(ids) == 0 {
+			return nil, nil, nil
+		}
+	}
+}
+
+// 根据id转换对象，已经不存在的文章直接跳过
 func (l *ArticlesLogic) articleByIds(ctx context.Context, articleIds []int64) ([]*model.Article, error) {
 	articles, err := mr.MapReduce[int64, model.Article, []*model.Article](func(source chan<- int64) {
 		for _, id := range articleIds {
@@ -224,8 +301,12 @@ func (l *ArticlesLogic) articleByIds(ctx context.Context, articleIds []int64) ([
 
 		log.Println("article1:", article)
 
+		if errors.Is(err, model.ErrNotFound) {
+			return
+		}
 		if err != nil {
 			cancel(err)
+			return
 		}
 		writer.Write(*article)
 	}, func(pipe <-chan model.Article, writer mr.Writer[[]*model.Article], cancel func(error)) {
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+)
+
+// 缓存中有已经不可见或已删除的文章时，从缓存中移除并补齐当前页
+func TestArticlesCacheRefill(t *testing.T) {
+	articles := newFakeArticleModel()
+	for id := int64(1); id <= 5; id++ {
+		articles.articles[id] = &model.Article{Id: id, AuthorId: 9, Status: types.ArticleStatusVisible, PublishTime: time.Unix(id*10, 0)}
+	}
+	articles.articles[4].Status = types.ArticleStatusNotPass
+	delete(articles.articles, 2)
+
+	svcCtx := newTestServiceContext(articles)
+	bizRedis, rds := newTestRedis(t)
+	svcCtx.BizRedis = bizRedis
+	key := articlesKey(9, types.SortPublishTime)
+	for id := int64(1); id <= 5; id++ {
+		if _, err := bizRedis.Zadd(key, id*10, strconv.FormatInt(id, 10)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bizRedis.Zadd(key, 0, "-1")
+
+	l := NewArticlesLogic(context.Background(), svcCtx)
+	pages := []struct {
+		ids    []int64
+		isEnd  bool
+		cursor int64
+	}{
+		{[]int64{5, 3}, false, 2},
+		{[]int64{1}, true, 2},
+	}
+	var cursor int64
+	for i, want := range pages {
+		resp, err := l.Articles(&pb.ArticlesRequest{UserId: 9, Cursor: cursor, PageSize: 2, SortType: types.SortPublishTime})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := articleIds(resp.Articles); !reflect.DeepEqual(ids, want.ids) || resp.IsEnd != want.isEnd || resp.Cursor != want.cursor {
+			t.Fatalf("page %d = %v, isEnd %v, cursor %d", i, ids, resp.IsEnd, resp.Cursor)
+		}
+		cursor = resp.Cursor
+	}
+
+	members := rds.members(key)
+	for _, id := range []string{"2", "4"} {
+		if _, ok := members[id]; ok {
+			t.Errorf("stale id %s still cached", id)
+		}
+	}
+	if len(members) != 4 {
+		t.Errorf("members = %v", members)
+	}
+}
@@ -0,0 +1,116 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+	"myBeyond/pkg/xcode"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ArticleUpdateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewArticleUpdateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ArticleUpdateLogic {
+	return &ArticleUpdateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ArticleUpdateLogic) ArticleUpdate(in *pb.ArticleUpdateRequest) (*pb.ArticleUpdateResponse, error) {
+	// 1、检查参数
+	in.Title = strings.TrimSpace(in.Title)
+	if len(in.Title) == 0 {
+		return nil, code.ArticleTitleCantEmpty
+	}
+
+	// 2、只有作者可以修改，草稿可以没有内容
+	article, err := findAuthorArticle(l.ctx, l.svcCtx, in.UserId, in.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	if article.Status != types.ArticleStatusDraft && len(strings.TrimSpace(in.Content)) == 0 {
+		return nil, code.ArticleContentCantEmpty
+	}
+	// 草稿在发布时再检查敏感词，其他文章命中时拒绝，audit模式下改为审核不通过
+	audit, err := reviseSensitive(l.ctx, l.svcCtx, article, in.Title, in.Description, in.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3、保存修改历史并修改文章，已经审核过的文章重新审核，同步到es和移出文章列表由mq订阅binlog完成
+	err = updateArticle(l.ctx, l.svcCtx, article, &model.Article{
+		Id:          article.Id,
+		Title:       in.Title,
+		Content:     in.Content,
+		Description: in.Description,
+		Cover:       in.Cover,
+	}, audit)
+	if err != nil {
+		l.Logger.Errorf("UpdateWithRevision req: %v error: %v", in, err)
+		return nil, err
+	}
+
+	return &pb.ArticleUpdateResponse{}, nil
+}
+
+// findAuthorArticle 查询用户自己的文章，已删除的文章按不存在处理
+func findAuthorArticle(ctx context.Context, svcCtx *svc.ServiceContext, userId, articleId int64) (*model.Article, error) {
+	if userId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if articleId <= 0 {
+		return nil, code.ArticleIdInvalid
+	}
+
+	article, err := svcCtx.ArticleModel.FindOne(ctx, articleId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.ArticleIdNotExist
+		}
+		logx.WithContext(ctx).Errorf("FindOne articleId: %d error: %v", articleId, err)
+		return nil, err
+	}
+	if article.Status == types.ArticleStatusUserDelete {
+		return nil, code.ArticleIdNotExist
+	}
+	if article.AuthorId != userId {
+		return nil, xcode.AccessDenied
+	}
+
+	return article, nil
+}
+
+// reviewedStatuses 已经审核过的文章状态，修改内容后需要重新审核
+var reviewedStatuses = []int{types.ArticleStatusVisible, types.ArticleStatusNotPass}
+
+// updateArticle 内容没有变化时不修改，避免产生空的修改历史
+// 可见和审核不通过的文章修改后改为待审核，audit不为nil时改为审核不通过并通知作者
+func updateArticle(ctx context.Context, svcCtx *svc.ServiceContext, old, data *model.Article, audit *model.ArticleAudit) error {
+	if old.Title == data.Title && old.Content == data.Content &&
+		old.Description == data.Description && old.Cover == data.Cover {
+		return nil
+	}
+
+	if err := svcCtx.ArticleModel.UpdateWithRevision(ctx, data, reviewedStatuses, types.ArticleStatusPending, audit); err != nil {
+		return err
+	}
+	if audit != nil {
+		article := *old
+		article.Title = data.Title
+		pushAuditMsg(svcCtx, &article, audit.OperatorId, int(audit.Status), audit.Reason)
+	}
+	return nil
+}
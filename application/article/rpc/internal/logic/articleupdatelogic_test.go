@@ -0,0 +1,222 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+	"myBeyond/pkg/xcode"
+)
+
+func TestArticleUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int64
+		req       *pb.ArticleUpdateRequest
+		err       error
+		wantState int64
+		revisions int
+	}{
+		{
+			name:      "visible needs review",
+			status:    types.ArticleStatusVisible,
+			req:       &pb.ArticleUpdateRequest{UserId: 2, ArticleId: 1, Title: "新标题", Content: "新内容"},
+			wantState: types.ArticleStatusPending,
+			revisions: 1,
+		},
+		{
+			name:      "not pass needs review",
+			status:    types.ArticleStatusNotPass,
+			req:       &pb.ArticleUpdateRequest{UserId: 2, ArticleId: 1, Title: "新标题", Content: "新内容"},
+			wantState: types.ArticleStatusPending,
+			revisions: 1,
+		},
+		{
+			name:      "draft stays draft",
+			status:    types.ArticleStatusDraft,
+			req:       &pb.ArticleUpdateRequest{UserId: 2, ArticleId: 1, Title: "新标题"},
+			wantState: types.ArticleStatusDraft,
+			revisions: 1,
+		},
+		{
+			name:      "unchanged",
+			status:    types.ArticleStatusVisible,
+			req:       &pb.ArticleUpdateRequest{UserId: 2, ArticleId: 1, Title: "标题", Content: "内容"},
+			wantState: types.ArticleStatusVisible,
+		},
+		{
+			name:      "not author",
+			status:    types.ArticleStatusVisible,
+			req:       &pb.ArticleUpdateRequest{UserId: 3, ArticleId: 1, Title: "新标题", Content: "新内容"},
+			err:       xcode.AccessDenied,
+			wantState: types.ArticleStatusVisible,
+		},
+		{
+			name:      "deleted",
+			status:    types.ArticleStatusUserDelete,
+			req:       &pb.ArticleUpdateRequest{UserId: 2, ArticleId: 1, Title: "新标题", Content: "新内容"},
+			err:       code.ArticleIdNotExist,
+			wantState: types.ArticleStatusUserDelete,
+		},
+		{
+			name:      "empty content",
+			status:    types.ArticleStatusVisible,
+			req:       &pb.ArticleUpdateRequest{UserId: 2, ArticleId: 1, Title: "新标题"},
+			err:       code.ArticleContentCantEmpty,
+			wantState: types.ArticleStatusVisible,
+		},
+		{
+			name:      "sensitive",
+			status:    types.ArticleStatusVisible,
+			req:       &pb.ArticleUpdateRequest{UserId: 2, ArticleId: 1, Title: "新标题", Content: "网络赌博"},
+			err:       code.ArticleHasSensitiveWord,
+			wantState: types.ArticleStatusVisible,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			articles := newFakeArticleModel(&model.Article{Id: 1, AuthorId: 2, Title: "标题", Content: "内容", Status: tt.status})
+			l := NewArticleUpdateLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeReject))
+
+			_, err := l.ArticleUpdate(tt.req)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if article := articles.articles[1]; article.Status != tt.wantState {
+				t.Errorf("status = %d, want %d", article.Status, tt.wantState)
+			}
+			if len(articles.revisions.revisions) != tt.revisions {
+				t.Fatalf("revisions = %d, want %d", len(articles.revisions.revisions), tt.revisions)
+			}
+			// 修改历史保存修改前的内容
+			if tt.revisions > 0 {
+				if r := articles.revisions.revisions[0]; r.Title != "标题" || r.Content != "内容" {
+					t.Errorf("revision = %+v", r)
+				}
+				if a := articles.articles[1]; a.Title != tt.req.Title || a.Content != tt.req.Content {
+					t.Errorf("article = %+v", a)
+				}
+			}
+		})
+	}
+}
+
+func TestArticleRevisions(t *testing.T) {
+	articles := newFakeArticleModel(
+		&model.Article{Id: 1, AuthorId: 2, Title: "v0", Content: "内容", Status: types.ArticleStatusDraft},
+		&model.Article{Id: 2, AuthorId: 2, Title: "其他", Content: "内容", Status: types.ArticleStatusDraft},
+	)
+	svcCtx := newTestServiceContext(articles)
+	ctx := context.Background()
+	for _, title := range []string{"v1", "v2", "v3"} {
+		if _, err := NewArticleUpdateLogic(ctx, svcCtx).ArticleUpdate(&pb.ArticleUpdateRequest{UserId: 2, ArticleId: 1, Title: title}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	NewArticleUpdateLogic(ctx, svcCtx).ArticleUpdate(&pb.ArticleUpdateRequest{UserId: 2, ArticleId: 2, Title: "其他v1"})
+
+	l := NewArticleRevisionsLogic(ctx, svcCtx)
+	resp, err := l.ArticleRevisions(&pb.ArticleRevisionsRequest{UserId: 2, ArticleId: 1, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Revisions) != 2 || resp.Revisions[0].Title != "v2" || resp.Revisions[1].Title != "v1" || resp.IsEnd {
+		t.Fatalf("first page = %+v", resp)
+	}
+	resp, err = l.ArticleRevisions(&pb.ArticleRevisionsRequest{UserId: 2, ArticleId: 1, PageSize: 2, Cursor: resp.Cursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Revisions) != 1 || resp.Revisions[0].Title != "v0" || !resp.IsEnd {
+		t.Fatalf("second page = %+v", resp)
+	}
+
+	// 只有作者可以查看
+	if _, err = l.ArticleRevisions(&pb.ArticleRevisionsRequest{UserId: 3, ArticleId: 1}); !errors.Is(err, xcode.AccessDenied) {
+		t.Fatalf("err = %v, want %v", err, xcode.AccessDenied)
+	}
+}
+
+func TestRestoreRevision(t *testing.T) {
+	articles := newFakeArticleModel(
+		&model.Article{Id: 1, AuthorId: 2, Title: "标题", Content: "内容", Status: types.ArticleStatusVisible},
+		&model.Article{Id: 2, AuthorId: 2, Title: "其他", Content: "内容", Status: types.ArticleStatusVisible},
+	)
+	articles.revisions.add(&model.Article{Id: 1, Title: "旧标题", Content: "旧内容"})
+	articles.revisions.add(&model.Article{Id: 2, Title: "其他旧标题", Content: "旧内容"})
+	l := NewRestoreRevisionLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeReject))
+
+	// 修改历史必须属于该文章
+	_, err := l.RestoreRevision(&pb.RestoreRevisionRequest{UserId: 2, ArticleId: 1, RevisionId: 2})
+	if !errors.Is(err, code.RevisionNotExist) {
+		t.Fatalf("err = %v, want %v", err, code.RevisionNotExist)
+	}
+	if _, err = l.RestoreRevision(&pb.RestoreRevisionRequest{UserId: 3, ArticleId: 1, RevisionId: 1}); !errors.Is(err, xcode.AccessDenied) {
+		t.Fatalf("err = %v, want %v", err, xcode.AccessDenied)
+	}
+
+	if _, err = l.RestoreRevision(&pb.RestoreRevisionRequest{UserId: 2, ArticleId: 1, RevisionId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	article := articles.articles[1]
+	if article.Title != "旧标题" || article.Content != "旧内容" || article.Status != types.ArticleStatusPending {
+		t.Errorf("article = %+v", article)
+	}
+	// 恢复前的内容保存为新的修改历史
+	last := articles.revisions.revisions[len(articles.revisions.revisions)-1]
+	if last.ArticleId != 1 || last.Title != "标题" || last.Content != "内容" {
+		t.Errorf("revision = %+v", last)
+	}
+}
+
+func TestArticleUpdateSensitiveAudit(t *testing.T) {
+	articles := newFakeArticleModel(&model.Article{Id: 1, AuthorId: 2, Title: "标题", Content: "内容", Status: types.ArticleStatusVisible})
+	l := NewArticleUpdateLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeAudit))
+
+	if _, err := l.ArticleUpdate(&pb.ArticleUpdateRequest{UserId: 2, ArticleId: 1, Title: "标题", Content: "网络赌博"}); err != nil {
+		t.Fatal(err)
+	}
+	if article := articles.articles[1]; article.Status != types.ArticleStatusNotPass || article.Content != "网络赌博" {
+		t.Errorf("article = %+v", article)
+	}
+	if len(articles.audits) != 1 || articles.audits[0].Reason != "包含敏感词：赌博" {
+		t.Errorf("audits = %+v", articles.audits)
+	}
+}
+
+// 恢复的历史内容同样检查敏感词
+func TestRestoreRevisionSensitive(t *testing.T) {
+	newArticles := func() *fakeArticleModel {
+		articles := newFakeArticleModel(&model.Article{Id: 1, AuthorId: 2, Title: "标题", Content: "内容", Status: types.ArticleStatusVisible})
+		articles.revisions.add(&model.Article{Id: 1, Title: "旧标题", Content: "网络赌博"})
+		return articles
+	}
+	req := &pb.RestoreRevisionRequest{UserId: 2, ArticleId: 1, RevisionId: 1}
+
+	articles := newArticles()
+	l := NewRestoreRevisionLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeReject))
+	if _, err := l.RestoreRevision(req); !errors.Is(err, code.ArticleHasSensitiveWord) {
+		t.Fatalf("err = %v, want %v", err, code.ArticleHasSensitiveWord)
+	}
+	if article := articles.articles[1]; article.Content != "内容" || article.Status != types.ArticleStatusVisible {
+		t.Errorf("rejected article = %+v", article)
+	}
+
+	articles = newArticles()
+	l = NewRestoreRevisionLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeAudit))
+	if _, err := l.RestoreRevision(req); err != nil {
+		t.Fatal(err)
+	}
+	if article := articles.articles[1]; article.Content != "网络赌博" || article.Status != types.ArticleStatusNotPass {
+		t.Errorf("audited article = %+v", article)
+	}
+	if len(articles.audits) != 1 || articles.audits[0].OperatorId != systemOperatorId ||
+		articles.audits[0].Status != types.ArticleStatusNotPass {
+		t.Errorf("audits = %+v", articles.audits)
+	}
+}
@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// auditArticle 文章状态在from中时改为审核结果to，写入审核记录后异步通知作者
+// 写入作者的文章列表和es由mq订阅binlog完成
+func auditArticle(ctx context.Context, svcCtx *svc.ServiceContext, operatorId, articleId int64, from []int, to int, reason string) error {
+	if operatorId <= 0 {
+		return code.UserIdInvalid
+	}
+	if articleId <= 0 {
+		return code.ArticleIdInvalid
+	}
+
+	// 1、检查文章当前状态
+	article, err := svcCtx.ArticleModel.FindOne(ctx, articleId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return code.ArticleIdNotExist
+		}
+		logx.WithContext(ctx).Errorf("FindOne articleId: %d error: %v", articleId, err)
+		return err
+	}
+	if !containsStatus(from, int(article.Status)) {
+		return code.ArticleCantAudit
+	}
+
+	// 2、按原状态修改，并发审核时只有一个成功
+	ok, err := svcCtx.ArticleModel.Audit(ctx, int(article.Status), &model.ArticleAudit{
+		ArticleId:  article.Id,
+		AuthorId:   article.AuthorId,
+		OperatorId: operatorId,
+		Status:     int64(to),
+		Reason:     reason,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Audit articleId: %d status: %d error: %v", articleId, to, err)
+		return err
+	}
+	if !ok {
+		return code.ArticleCantAudit
+	}
+
+	// 3、异步通知作者
+	pushAuditMsg(svcCtx, article, operatorId, to, reason)
+	return nil
+}
+
+// pushAuditMsg 发送kafka消息，异步
+func pushAuditMsg(svcCtx *svc.ServiceContext, article *model.Article, operatorId int64, status int, reason string) {
+	msg := &types.ArticleAuditMsg{
+		ArticleId:  article.Id,
+		AuthorId:   article.AuthorId,
+		Title:      article.Title,
+		Status:     status,
+		Reason:     reason,
+		AuditTime:  time.Now().Unix(),
+		OperatorId: operatorId,
+	}
+	threading.GoSafe(func() {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			logx.Errorf("[Audit] marshal msg: %+v error: %v", msg, err)
+			return
+		}
+		if err = svcCtx.KqPusherClient.Push(string(data)); err != nil {
+			logx.Errorf("[Audit] kq push data: %s error: %v", data, err)
+		}
+	})
+}
+
+func containsStatus(statuses []int, status int) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+)
+
+func TestApproveArticle(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		err    error
+	}{
+		{"pending", types.ArticleStatusPending, nil},
+		{"not pass", types.ArticleStatusNotPass, nil},
+		{"visible", types.ArticleStatusVisible, code.ArticleCantAudit},
+		{"deleted", types.ArticleStatusUserDelete, code.ArticleCantAudit},
+		{"draft", types.ArticleStatusDraft, code.ArticleCantAudit},
+		{"scheduled", types.ArticleStatusScheduled, code.ArticleCantAudit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			articles := newFakeArticleModel(&model.Article{Id: 1, AuthorId: 2, Status: int64(tt.status)})
+			l := NewApproveArticleLogic(context.Background(), newTestServiceContext(articles))
+
+			_, err := l.ApproveArticle(&pb.ApproveArticleRequest{OperatorId: 9, ArticleId: 1})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				if articles.articles[1].Status != int64(tt.status) || len(articles.audits) != 0 {
+					t.Errorf("status = %d, audits = %d", articles.articles[1].Status, len(articles.audits))
+				}
+				return
+			}
+			if articles.articles[1].Status != types.ArticleStatusVisible {
+				t.Errorf("status = %d", articles.articles[1].Status)
+			}
+			audit := articles.audits[0]
+			if audit.AuthorId != 2 || audit.OperatorId != 9 || audit.Status != types.ArticleStatusVisible {
+				t.Errorf("audit = %+v", audit)
+			}
+		})
+	}
+}
+
+func TestRejectArticle(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		reason string
+		err    error
+	}{
+		{"pending", types.ArticleStatusPending, "广告", nil},
+		{"visible", types.ArticleStatusVisible, "广告", nil},
+		{"not pass", types.ArticleStatusNotPass, "广告", code.ArticleCantAudit},
+		{"deleted", types.ArticleStatusUserDelete, "广告", code.ArticleCantAudit},
+		{"draft", types.ArticleStatusDraft, "广告", code.ArticleCantAudit},
+		{"empty reason", types.ArticleStatusPending, "  ", code.AuditReasonInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			articles := newFakeArticleModel(&model.Article{Id: 1, AuthorId: 2, Status: int64(tt.status)})
+			l := NewRejectArticleLogic(context.Background(), newTestServiceContext(articles))
+
+			_, err := l.RejectArticle(&pb.RejectArticleRequest{OperatorId: 9, ArticleId: 1, Reason: tt.reason})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				if articles.articles[1].Status != int64(tt.status) || len(articles.audits) != 0 {
+					t.Errorf("status = %d, audits = %d", articles.articles[1].Status, len(articles.audits))
+				}
+				return
+			}
+			if articles.articles[1].Status != types.ArticleStatusNotPass {
+				t.Errorf("status = %d", articles.articles[1].Status)
+			}
+			if audit := articles.audits[0]; audit.Reason != tt.reason || audit.Status != types.ArticleStatusNotPass {
+				t.Errorf("audit = %+v", audit)
+			}
+		})
+	}
+}
+
+func TestAuditArticleInvalid(t *testing.T) {
+	svcCtx := newTestServiceContext(newFakeArticleModel(&model.Article{Id: 1, Status: types.ArticleStatusPending}))
+	from := []int{types.ArticleStatusPending}
+
+	if err := auditArticle(context.Background(), svcCtx, 0, 1, from, types.ArticleStatusVisible, ""); !errors.Is(err, code.UserIdInvalid) {
+		t.Errorf("operator 0: err = %v", err)
+	}
+	if err := auditArticle(context.Background(), svcCtx, 9, 0, from, types.ArticleStatusVisible, ""); !errors.Is(err, code.ArticleIdInvalid) {
+		t.Errorf("article 0: err = %v", err)
+	}
+	if err := auditArticle(context.Background(), svcCtx, 9, 2, from, types.ArticleStatusVisible, ""); !errors.Is(err, code.ArticleIdNotExist) {
+		t.Errorf("article 2: err = %v", err)
+	}
+}
+
+// 读取文章状态后文章已被其他审核员处理，本次审核失败
+func TestAuditArticleConcurrent(t *testing.T) {
+	articles := newFakeArticleModel(&model.Article{Id: 1, Status: types.ArticleStatusPending})
+	articles.beforeAudit = func() {
+		articles.articles[1].Status = types.ArticleStatusNotPass
+	}
+	svcCtx := newTestServiceContext(articles)
+
+	err := auditArticle(context.Background(), svcCtx, 9, 1,
+		[]int{types.ArticleStatusPending, types.ArticleStatusVisible}, types.ArticleStatusNotPass, "广告")
+	if !errors.Is(err, code.ArticleCantAudit) {
+		t.Fatalf("err = %v, want %v", err, code.ArticleCantAudit)
+	}
+	if len(articles.audits) != 0 {
+		t.Errorf("audits = %+v", articles.audits)
+	}
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/follow/rpc/follow"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 判断当前用户与作者之间是否存在拉黑关系，未登录或查看自己的文章时不需要判断
+// 关注服务不可用时返回错误，无法确认没有拉黑关系时不返回文章
+func isAuthorBlocked(ctx context.Context, svcCtx *svc.ServiceContext, viewerId, authorId int64) (bool, error) {
+	blocked, err := blockedAuthors(ctx, svcCtx, viewerId, []int64{authorId})
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := blocked[authorId]
+	return ok, nil
+}
+
+// blockedAuthors 批量查询与当前用户存在拉黑关系的作者
+func blockedAuthors(ctx context.Context, svcCtx *svc.ServiceContext, viewerId int64, authorIds []int64) (map[int64]struct{}, error) {
+	if viewerId <= 0 {
+		return nil, nil
+	}
+	targets := make([]int64, 0, len(authorIds))
+	seen := make(map[int64]struct{}, len(authorIds))
+	for _, id := range authorIds {
+		if _, ok := seen[id]; ok || id == viewerId {
+			continue
+		}
+		seen[id] = struct{}{}
+		targets = append(targets, id)
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	ret, err := svcCtx.FollowRPC.FindBlocked(ctx, &follow.FindBlockedRequest{
+		UserId:        viewerId,
+		TargetUserIds: targets,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("FindBlocked viewerId: %d authorIds: %v error: %v", viewerId, targets, err)
+		return nil, err
+	}
+
+	blocked := make(map[int64]struct{}, len(ret.BlockedUserIds))
+	for _, id := range ret.BlockedUserIds {
+		blocked[id] = struct{}{}
+	}
+	return blocked, nil
+}
@@ -0,0 +1,93 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/follow/rpc/follow"
+
+	"google.golang.org/grpc"
+)
+
+// 接口中有Follow方法，直接嵌入follow.Follow时字段名与方法冲突，通过别名嵌入
+type followClient = follow.Follow
+
+type fakeFollowRPC struct {
+	followClient
+	blocked []int64
+	err     error
+	calls   int
+	req     *follow.FindBlockedRequest
+}
+
+func (f *fakeFollowRPC) FindBlocked(ctx context.Context, in *follow.FindBlockedRequest, opts ...grpc.CallOption) (*follow.FindBlockedResponse, error) {
+	f.calls++
+	f.req = in
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &follow.FindBlockedResponse{BlockedUserIds: f.blocked}, nil
+}
+
+func TestBlockedAuthors(t *testing.T) {
+	rpc := &fakeFollowRPC{blocked: []int64{3}}
+	svcCtx := &svc.ServiceContext{FollowRPC: rpc}
+
+	blocked, err := blockedAuthors(context.Background(), svcCtx, 1, []int64{3, 1, 4, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(blocked, map[int64]struct{}{3: {}}) {
+		t.Errorf("blocked = %v", blocked)
+	}
+	// 去掉自己和重复的作者
+	if !reflect.DeepEqual(rpc.req.TargetUserIds, []int64{3, 4}) {
+		t.Errorf("target ids = %v", rpc.req.TargetUserIds)
+	}
+}
+
+func TestBlockedAuthorsSkip(t *testing.T) {
+	rpc := &fakeFollowRPC{}
+	svcCtx := &svc.ServiceContext{FollowRPC: rpc}
+
+	// 未登录
+	if _, err := blockedAuthors(context.Background(), svcCtx, 0, []int64{3}); err != nil {
+		t.Fatal(err)
+	}
+	// 查看自己的文章
+	if _, err := blockedAuthors(context.Background(), svcCtx, 3, []int64{3}); err != nil {
+		t.Fatal(err)
+	}
+	if rpc.calls != 0 {
+		t.Errorf("FindBlocked called %d times", rpc.calls)
+	}
+}
+
+func TestIsAuthorBlockedFailClosed(t *testing.T) {
+	rpcErr := errors.New("follow rpc unavailable")
+	svcCtx := &svc.ServiceContext{FollowRPC: &fakeFollowRPC{err: rpcErr}}
+
+	blocked, err := isAuthorBlocked(context.Background(), svcCtx, 1, 3)
+	if !errors.Is(err, rpcErr) {
+		t.Fatalf("err = %v, want %v", err, rpcErr)
+	}
+	if blocked {
+		t.Error("blocked should be false when err is returned")
+	}
+}
+
+func TestIsAuthorBlocked(t *testing.T) {
+	svcCtx := &svc.ServiceContext{FollowRPC: &fakeFollowRPC{blocked: []int64{3}}}
+
+	blocked, err := isAuthorBlocked(context.Background(), svcCtx, 1, 3)
+	if err != nil || !blocked {
+		t.Errorf("isAuthorBlocked = %v, %v", blocked, err)
+	}
+	blocked, err = isAuthorBlocked(context.Background(), &svc.ServiceContext{FollowRPC: &fakeFollowRPC{}}, 1, 3)
+	if err != nil || blocked {
+		t.Errorf("isAuthorBlocked = %v, %v", blocked, err)
+	}
+}
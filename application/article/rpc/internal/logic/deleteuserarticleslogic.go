@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 每批软删除的文章数
+const deleteUserArticlesBatch = 100
+
+type DeleteUserArticlesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteUserArticlesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteUserArticlesLogic {
+	return &DeleteUserArticlesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteUserArticles 软删除用户的所有文章并删除文章列表缓存，重复调用是安全的
+func (l *DeleteUserArticlesLogic) DeleteUserArticles(in *pb.DeleteUserArticlesRequest) (*pb.DeleteUserArticlesResponse, error) {
+	// 1、检查参数
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+
+	// 2、分批软删除文章
+	var (
+		lastId int64
+		count  int64
+	)
+	for {
+		ids, err := l.svcCtx.ArticleModel.FindIdsByAuthorId(l.ctx, in.UserId, lastId, types.ArticleStatusUserDelete, deleteUserArticlesBatch)
+		if err != nil {
+			l.Logger.Errorf("FindIdsByAuthorId userId: %d lastId: %d error: %v", in.UserId, lastId, err)
+			return nil, err
+		}
+		for _, id := range ids {
+			err = l.svcCtx.ArticleModel.UpdateArticleStatus(l.ctx, id, types.ArticleStatusUserDelete)
+			if err != nil {
+				l.Logger.Errorf("UpdateArticleStatus articleId: %d error: %v", id, err)
+				return nil, err
+			}
+			lastId = id
+			count++
+		}
+		if len(ids) < deleteUserArticlesBatch {
+			break
+		}
+	}
+
+	// 3、删除各种排序的文章列表缓存
+	_, err := l.svcCtx.BizRedis.DelCtx(l.ctx,
+		articlesKey(in.UserId, types.SortPublishTime),
+		articlesKey(in.UserId, types.SortLikeCount))
+	if err != nil {
+		l.Logger.Errorf("DelCtx articles cache userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+
+	return &pb.DeleteUserArticlesResponse{Count: count}, nil
+}
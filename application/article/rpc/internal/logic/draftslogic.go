@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// draftStatuses 还没有发布的文章状态
+var draftStatuses = []int{types.ArticleStatusDraft, types.ArticleStatusScheduled}
+
+type DraftsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDraftsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DraftsLogic {
+	return &DraftsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DraftsLogic) Drafts(in *pb.DraftsRequest) (*pb.DraftsResponse, error) {
+	// 1、检查参数
+	if in.UserId <= 0 {
+		return nil, code.UserIdInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultDraftPageSize
+	}
+	if in.PageSize > types.MaxDraftPageSize {
+		in.PageSize = types.MaxDraftPageSize
+	}
+
+	// 2、多查一条判断是否还有下一页
+	articles, err := l.svcCtx.ArticleModel.FindByAuthorAndStatus(l.ctx, in.UserId, draftStatuses, in.Cursor, int(in.PageSize)+1)
+	if err != nil {
+		l.Logger.Errorf("FindByAuthorAndStatus req: %v error: %v", in, err)
+		return nil, err
+	}
+	isEnd := len(articles) <= int(in.PageSize)
+	if !isEnd {
+		articles = articles[:in.PageSize]
+	}
+
+	items := make([]*pb.DraftItem, 0, len(articles))
+	for _, a := range articles {
+		items = append(items, &pb.DraftItem{
+			ArticleId:   a.Id,
+			Title:       a.Title,
+			Description: a.Description,
+			Cover:       a.Cover,
+			Status:      int32(a.Status),
+			PublishTime: a.PublishTime.Unix(),
+			UpdateTime:  a.UpdateTime.Unix(),
+		})
+	}
+	var cursor int64
+	if len(items) > 0 {
+		cursor = items[len(items)-1].ArticleId
+	}
+
+	return &pb.DraftsResponse{
+		Drafts: items,
+		IsEnd:  isEnd,
+		Cursor: cursor,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+)
+
+func TestDrafts(t *testing.T) {
+	articles := newFakeArticleModel(
+		&model.Article{Id: 1, AuthorId: 2, Status: types.ArticleStatusDraft},
+		&model.Article{Id: 2, AuthorId: 2, Status: types.ArticleStatusVisible},
+		&model.Article{Id: 3, AuthorId: 2, Status: types.ArticleStatusScheduled},
+		&model.Article{Id: 4, AuthorId: 3, Status: types.ArticleStatusDraft},
+		&model.Article{Id: 5, AuthorId: 2, Status: types.ArticleStatusDraft},
+	)
+	l := NewDraftsLogic(context.Background(), newTestServiceContext(articles))
+
+	// 只返回自己的草稿和定时发布的文章，按id倒序
+	var ids []int64
+	var cursor int64
+	for page := 0; ; page++ {
+		if page > 3 {
+			t.Fatal("too many pages")
+		}
+		resp, err := l.Drafts(&pb.DraftsRequest{UserId: 2, Cursor: cursor, PageSize: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Drafts) > 2 {
+			t.Fatalf("page size = %d", len(resp.Drafts))
+		}
+		for _, d := range resp.Drafts {
+			ids = append(ids, d.ArticleId)
+		}
+		cursor = resp.Cursor
+		if resp.IsEnd {
+			break
+		}
+	}
+
+	want := []int64{5, 3, 1}
+	if len(ids) != len(want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("ids = %v, want %v", ids, want)
+		}
+	}
+}
+
+// 刚好一页时isEnd为true，不需要再请求一次
+func TestDraftsExactPage(t *testing.T) {
+	articles := newFakeArticleModel(
+		&model.Article{Id: 1, AuthorId: 2, Status: types.ArticleStatusDraft},
+		&model.Article{Id: 2, AuthorId: 2, Status: types.ArticleStatusDraft},
+	)
+	resp, err := NewDraftsLogic(context.Background(), newTestServiceContext(articles)).Drafts(&pb.DraftsRequest{UserId: 2, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Drafts) != 2 || !resp.IsEnd || resp.Cursor != 1 {
+		t.Errorf("resp = %+v", resp)
+	}
+}
+
+func TestDraftsInvalidUser(t *testing.T) {
+	_, err := NewDraftsLogic(context.Background(), newTestServiceContext(newFakeArticleModel())).Drafts(&pb.DraftsRequest{})
+	if !errors.Is(err, code.UserIdInvalid) {
+		t.Errorf("err = %v, want %v", err, code.UserIdInvalid)
+	}
+}
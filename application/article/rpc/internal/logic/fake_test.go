@@ -0,0 +1,347 @@
+package logic
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/internal/svc"
+
+	"github.com/zeromicro/go-queue/kq"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// fakeArticleModel 在内存中保存文章，只实现测试用到的方法
+type fakeArticleModel struct {
+	model.ArticleModel
+	articles map[int64]*model.Article
+	audits   []*model.ArticleAudit
+	limits   []int
+	nextId   int64
+	err      error
+	// UpdateWithRevision写入的修改历史
+	revisions *fakeRevisionModel
+	// 在Audit修改状态前调用，用于模拟并发审核
+	beforeAudit func()
+	// 在CompareAndSetStatus修改状态前调用，用于模拟并发发布
+	beforeSetStatus func()
+}
+
+func newFakeArticleModel(articles ...*model.Article) *fakeArticleModel {
+	m := &fakeArticleModel{articles: make(map[int64]*model.Article), revisions: &fakeRevisionModel{}}
+	for _, a := range articles {
+		m.articles[a.Id] = a
+	}
+	return m
+}
+
+func (m *fakeArticleModel) FindOne(_ context.Context, id int64) (*model.Article, error) {
+	a, ok := m.articles[id]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	article := *a
+	return &article, nil
+}
+
+func (m *fakeArticleModel) Audit(_ context.Context, from int, data *model.ArticleAudit) (bool, error) {
+	if m.beforeAudit != nil {
+		m.beforeAudit()
+	}
+	a, ok := m.articles[data.ArticleId]
+	if !ok || a.Status != int64(from) {
+		return false, nil
+	}
+	a.Status = data.Status
+	m.audits = append(m.audits, data)
+	return true, nil
+}
+
+func (m *fakeArticleModel) FindByStatus(_ context.Context, status int, cursor int64, limit int) ([]*model.Article, error) {
+	m.limits = append(m.limits, limit)
+	var articles []*model.Article
+	for _, a := range m.articles {
+		if a.Status == int64(status) && a.Id > cursor {
+			articles = append(articles, a)
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool { return articles[i].Id < articles[j].Id })
+	if len(articles) > limit {
+		articles = articles[:limit]
+	}
+	return articles, nil
+}
+
+func (m *fakeArticleModel) FindByAuthorAndStatus(_ context.Context, authorId int64, statuses []int, cursor int64, limit int) ([]*model.Article, error) {
+	var articles []*model.Article
+	for _, a := range m.articles {
+		if a.AuthorId == authorId && containsStatus(statuses, int(a.Status)) && (cursor <= 0 || a.Id < cursor) {
+			articles = append(articles, a)
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool { return articles[i].Id > articles[j].Id })
+	if len(articles) > limit {
+		articles = articles[:limit]
+	}
+	return articles, nil
+}
+
+func (m *fakeArticleModel) CompareAndSetStatus(_ context.Context, id int64, from, to int, publishTime time.Time) (bool, error) {
+	if m.beforeSetStatus != nil {
+		m.beforeSetStatus()
+	}
+	a, ok := m.articles[id]
+	if !ok || a.Status != int64(from) {
+		return false, nil
+	}
+	a.Status, a.PublishTime = int64(to), publishTime
+	return true, nil
+}
+
+type fakeResult struct {
+	id int64
+}
+
+func (r fakeResult) LastInsertId() (int64, error) { return r.id, nil }
+
+func (r fakeResult) RowsAffected() (int64, error) { return 1, nil }
+
+func (m *fakeArticleModel) insert(data *model.Article) int64 {
+	m.nextId++
+	article := *data
+	article.Id = m.nextId
+	m.articles[article.Id] = &article
+	return article.Id
+}
+
+func (m *fakeArticleModel) Insert(_ context.Context, data *model.Article) (sql.Result, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return fakeResult{id: m.insert(data)}, nil
+}
+
+func (m *fakeArticleModel) InsertWithAudit(_ context.Context, data *model.Article, audit *model.ArticleAudit) (int64, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	id := m.insert(data)
+	record := *audit
+	record.ArticleId = id
+	m.audits = append(m.audits, &record)
+	return id, nil
+}
+
+func (m *fakeArticleModel) UpdateWithRevision(_ context.Context, data *model.Article, from []int, to int, audit *model.ArticleAudit) error {
+	if m.err != nil {
+		return m.err
+	}
+	a, ok := m.articles[data.Id]
+	if !ok {
+		return model.ErrNotFound
+	}
+	m.revisions.add(a)
+	a.Title, a.Content, a.Cover, a.Description = data.Title, data.Content, data.Cover, data.Description
+	if containsStatus(from, int(a.Status)) {
+		a.Status = int64(to)
+	}
+	if audit != nil {
+		a.Status = audit.Status
+		m.audits = append(m.audits, audit)
+	}
+	return nil
+}
+
+// fakeRevisionModel 在内存中保存修改历史，id按写入顺序递增
+type fakeRevisionModel struct {
+	model.ArticleRevisionModel
+	revisions []*model.ArticleRevision
+}
+
+func (m *fakeRevisionModel) add(a *model.Article) {
+	m.revisions = append(m.revisions, &model.ArticleRevision{
+		Id:          int64(len(m.revisions) + 1),
+		ArticleId:   a.Id,
+		Title:       a.Title,
+		Content:     a.Content,
+		Cover:       a.Cover,
+		Description: a.Description,
+	})
+}
+
+func (m *fakeRevisionModel) FindOne(_ context.Context, id int64) (*model.ArticleRevision, error) {
+	if id <= 0 || id > int64(len(m.revisions)) {
+		return nil, model.ErrNotFound
+	}
+	return m.revisions[id-1], nil
+}
+
+func (m *fakeRevisionModel) FindByArticleId(_ context.Context, articleId, cursor int64, limit int) ([]*model.ArticleRevision, error) {
+	var revisions []*model.ArticleRevision
+	for i := len(m.revisions) - 1; i >= 0 && len(revisions) < limit; i-- {
+		r := m.revisions[i]
+		if r.ArticleId == articleId && (cursor <= 0 || r.Id < cursor) {
+			revisions = append(revisions, r)
+		}
+	}
+	return revisions, nil
+}
+
+func newTestServiceContext(articles *fakeArticleModel) *svc.ServiceContext {
+	return &svc.ServiceContext{
+		ArticleModel:  articles,
+		RevisionModel: articles.revisions,
+		// 消息只在内存中缓冲，测试结束前不会发送
+		KqPusherClient: kq.NewPusher([]string{"127.0.0.1:0"}, "article-audit", kq.WithFlushInterval(time.Hour)),
+	}
+}
+
+// fakeRedis 只实现文章列表缓存用到的有序集合命令
+type fakeRedis struct {
+	mu    sync.Mutex
+	zsets map[string]map[string]int64
+}
+
+func newTestRedis(t *testing.T) (*redis.Redis, *fakeRedis) {
+	rds := &fakeRedis{zsets: make(map[string]map[string]int64)}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go rds.serve(conn)
+		}
+	}()
+
+	return redis.New(ln.Addr().String()), rds
+}
+
+func (r *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		io.WriteString(conn, r.handle(args))
+	}
+}
+
+func (r *fakeRedis) handle(args []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "EXISTS":
+		if _, ok := r.zsets[args[1]]; ok {
+			return ":1\r\n"
+		}
+		return ":0\r\n"
+	case "EXPIRE":
+		return ":1\r\n"
+	case "ZADD":
+		set, ok := r.zsets[args[1]]
+		if !ok {
+			set = make(map[string]int64)
+			r.zsets[args[1]] = set
+		}
+		var added int
+		for i := 2; i+1 < len(args); i += 2 {
+			if _, ok := set[args[i+1]]; !ok {
+				added++
+			}
+			set[args[i+1]], _ = strconv.ParseInt(args[i], 10, 64)
+		}
+		return fmt.Sprintf(":%d\r\n", added)
+	case "ZREM":
+		var removed int
+		for _, member := range args[2:] {
+			if _, ok := r.zsets[args[1]][member]; ok {
+				delete(r.zsets[args[1]], member)
+				removed++
+			}
+		}
+		return fmt.Sprintf(":%d\r\n", removed)
+	case "ZREVRANGEBYSCORE":
+		// key max min WITHSCORES LIMIT offset count
+		max, _ := strconv.ParseInt(args[2], 10, 64)
+		min, _ := strconv.ParseInt(args[3], 10, 64)
+		offset, _ := strconv.Atoi(args[6])
+		count, _ := strconv.Atoi(args[7])
+		var members []string
+		for member, score := range r.zsets[args[1]] {
+			if score >= min && score <= max {
+				members = append(members, member)
+			}
+		}
+		set := r.zsets[args[1]]
+		sort.Slice(members, func(i, j int) bool {
+			if set[members[i]] != set[members[j]] {
+				return set[members[i]] > set[members[j]]
+			}
+			return members[i] > members[j]
+		})
+		if offset > len(members) {
+			offset = len(members)
+		}
+		members = members[offset:]
+		if len(members) > count {
+			members = members[:count]
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "*%d\r\n", 2*len(members))
+		for _, member := range members {
+			score := strconv.FormatInt(set[member], 10)
+			fmt.Fprintf(&b, "$%d\r\n%s\r\n$%d\r\n%s\r\n", len(member), member, len(score), score)
+		}
+		return b.String()
+	}
+	return "-ERR unknown command '" + args[0] + "'\r\n"
+}
+
+func (r *fakeRedis) members(key string) map[string]int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.zsets[key]
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err = reader.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(arg, "\r\n")
+	}
+	return args, nil
+}
@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ModerationQueueLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewModerationQueueLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ModerationQueueLogic {
+	return &ModerationQueueLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ModerationQueueLogic) ModerationQueue(in *pb.ModerationQueueRequest) (*pb.ModerationQueueResponse, error) {
+	// 1、检查参数，只能查询审核相关的状态
+	status := int(in.Status)
+	if status != types.ArticleStatusPending && status != types.ArticleStatusNotPass && status != types.ArticleStatusVisible {
+		return nil, code.ArticleStatusInvalid
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultModerationPageSize
+	}
+	if in.PageSize > types.MaxModerationPageSize {
+		in.PageSize = types.MaxModerationPageSize
+	}
+
+	// 2、多查一条判断是否还有下一页
+	articles, err := l.svcCtx.ArticleModel.FindByStatus(l.ctx, status, in.Cursor, int(in.PageSize)+1)
+	if err != nil {
+		l.Logger.Errorf("FindByStatus req: %v error: %v", in, err)
+		return nil, err
+	}
+	isEnd := len(articles) <= int(in.PageSize)
+	if !isEnd {
+		articles = articles[:in.PageSize]
+	}
+
+	items := make([]*pb.ArticleItem, 0, len(articles))
+	for _, a := range articles {
+		items = append(items, &pb.ArticleItem{
+			Id:           a.Id,
+			Title:        a.Title,
+			Content:      a.Content,
+			Description:  a.Description,
+			Cover:        a.Cover,
+			CommentCount: a.CommentNum,
+			LikeCount:    a.LikeNum,
+			PublishTime:  a.PublishTime.Unix(),
+			AuthorId:     a.AuthorId,
+			Status:       int32(a.Status),
+		})
+	}
+	var cursor int64
+	if len(items) > 0 {
+		cursor = items[len(items)-1].Id
+	}
+
+	return &pb.ModerationQueueResponse{
+		Articles: items,
+		IsEnd:    isEnd,
+		Cursor:   cursor,
+	}, nil
+}
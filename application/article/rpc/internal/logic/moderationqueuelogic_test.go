@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+)
+
+func seedArticles(status int, ids ...int64) []*model.Article {
+	articles := make([]*model.Article, 0, len(ids))
+	for _, id := range ids {
+		articles = append(articles, &model.Article{Id: id, Status: int64(status)})
+	}
+	return articles
+}
+
+func articleIds(items []*pb.ArticleItem) []int64 {
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	return ids
+}
+
+func TestModerationQueue(t *testing.T) {
+	seed := append(seedArticles(types.ArticleStatusPending, 1, 2, 4, 5, 7), seedArticles(types.ArticleStatusVisible, 3, 6)...)
+	l := NewModerationQueueLogic(context.Background(), newTestServiceContext(newFakeArticleModel(seed...)))
+
+	pages := []struct {
+		ids    []int64
+		isEnd  bool
+		cursor int64
+	}{
+		{[]int64{1, 2}, false, 2},
+		{[]int64{4, 5}, false, 5},
+		{[]int64{7}, true, 7},
+	}
+	var cursor int64
+	for i, want := range pages {
+		resp, err := l.ModerationQueue(&pb.ModerationQueueRequest{Status: types.ArticleStatusPending, Cursor: cursor, PageSize: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := articleIds(resp.Articles); !reflect.DeepEqual(ids, want.ids) || resp.IsEnd != want.isEnd || resp.Cursor != want.cursor {
+			t.Fatalf("page %d = %v, isEnd %v, cursor %d", i, ids, resp.IsEnd, resp.Cursor)
+		}
+		cursor = resp.Cursor
+	}
+}
+
+// 最后一页正好满页时不需要再请求一次空页
+func TestModerationQueueFullLastPage(t *testing.T) {
+	l := NewModerationQueueLogic(context.Background(),
+		newTestServiceContext(newFakeArticleModel(seedArticles(types.ArticleStatusNotPass, 1, 2, 3, 4)...)))
+
+	resp, err := l.ModerationQueue(&pb.ModerationQueueRequest{Status: types.ArticleStatusNotPass, Cursor: 2, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := articleIds(resp.Articles); !reflect.DeepEqual(ids, []int64{3, 4}) || !resp.IsEnd || resp.Cursor != 4 {
+		t.Errorf("page = %v, isEnd %v, cursor %d", ids, resp.IsEnd, resp.Cursor)
+	}
+
+	resp, err = l.ModerationQueue(&pb.ModerationQueueRequest{Status: types.ArticleStatusNotPass, Cursor: 4, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Articles) != 0 || !resp.IsEnd || resp.Cursor != 0 {
+		t.Errorf("empty page = %v, isEnd %v, cursor %d", articleIds(resp.Articles), resp.IsEnd, resp.Cursor)
+	}
+}
+
+func TestModerationQueuePageSize(t *testing.T) {
+	articles := newFakeArticleModel()
+	l := NewModerationQueueLogic(context.Background(), newTestServiceContext(articles))
+
+	for _, size := range []int64{0, -1, types.MaxModerationPageSize + 1} {
+		if _, err := l.ModerationQueue(&pb.ModerationQueueRequest{PageSize: size}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []int{types.DefaultModerationPageSize + 1, types.DefaultModerationPageSize + 1, types.MaxModerationPageSize + 1}
+	if !reflect.DeepEqual(articles.limits, want) {
+		t.Errorf("limits = %v, want %v", articles.limits, want)
+	}
+}
+
+func TestModerationQueueStatusInvalid(t *testing.T) {
+	l := NewModerationQueueLogic(context.Background(), newTestServiceContext(newFakeArticleModel()))
+
+	for _, status := range []int32{types.ArticleStatusUserDelete, types.ArticleStatusDraft, types.ArticleStatusScheduled, -1} {
+		if _, err := l.ModerationQueue(&pb.ModerationQueueRequest{Status: status}); !errors.Is(err, code.ArticleStatusInvalid) {
+			t.Errorf("status %d: err = %v", status, err)
+		}
+	}
+}
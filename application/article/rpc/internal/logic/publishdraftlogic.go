@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PublishDraftLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPublishDraftLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishDraftLogic {
+	return &PublishDraftLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *PublishDraftLogic) PublishDraft(in *pb.PublishDraftRequest) (*pb.PublishDraftResponse, error) {
+	// 1、只有作者可以发布，且只能发布草稿和定时发布的文章
+	article, err := findAuthorArticle(l.ctx, l.svcCtx, in.UserId, in.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+	if article.Status != types.ArticleStatusDraft && article.Status != types.ArticleStatusScheduled {
+		return nil, code.ArticleNotDraft
+	}
+	if len(strings.TrimSpace(article.Content)) == 0 {
+		return nil, code.ArticleContentCantEmpty
+	}
+	status, publishTime, err := publishStatus(time.Now(), in.PublishTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2、检查敏感词，audit模式下改为审核不通过
+	if words := matchSensitive(l.svcCtx, article.Title, article.Description, article.Content); len(words) > 0 {
+		l.Logger.Infof("PublishDraft articleId: %d hit sensitive words: %v", article.Id, words)
+		if !sensitiveAuditMode(l.svcCtx) {
+			return nil, code.ArticleHasSensitiveWord
+		}
+		if err = l.rejectSensitive(article, words); err != nil {
+			return nil, err
+		}
+		return &pb.PublishDraftResponse{}, nil
+	}
+
+	// 3、按原状态修改，避免和定时任务重复发布；写入文章列表由mq订阅binlog完成
+	ok, err := l.svcCtx.ArticleModel.CompareAndSetStatus(l.ctx, article.Id, int(article.Status), status, publishTime)
+	if err != nil {
+		l.Logger.Errorf("CompareAndSetStatus req: %v error: %v", in, err)
+		return nil, err
+	}
+	if !ok {
+		return nil, code.ArticleNotDraft
+	}
+
+	return &pb.PublishDraftResponse{}, nil
+}
+
+func (l *PublishDraftLogic) rejectSensitive(article *model.Article, words []string) error {
+	audit := sensitiveAudit(article, words)
+	ok, err := l.svcCtx.ArticleModel.Audit(l.ctx, int(article.Status), audit)
+	if err != nil {
+		l.Logger.Errorf("Audit articleId: %d error: %v", article.Id, err)
+		return err
+	}
+	if !ok {
+		return code.ArticleNotDraft
+	}
+
+	pushAuditMsg(l.svcCtx, article, systemOperatorId, types.ArticleStatusNotPass, audit.Reason)
+	return nil
+}
@@ -0,0 +1,149 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+	"myBeyond/pkg/xcode"
+)
+
+func TestPublishDraft(t *testing.T) {
+	later := time.Now().Add(time.Hour).Unix()
+	tests := []struct {
+		name        string
+		status      int64
+		content     string
+		req         *pb.PublishDraftRequest
+		err         error
+		wantState   int64
+		publishTime int64 // 为0时不检查
+	}{
+		{
+			name:      "publish now",
+			status:    types.ArticleStatusDraft,
+			content:   "内容",
+			req:       &pb.PublishDraftRequest{UserId: 2, ArticleId: 1},
+			wantState: types.ArticleStatusPending,
+		},
+		{
+			name:        "schedule",
+			status:      types.ArticleStatusDraft,
+			content:     "内容",
+			req:         &pb.PublishDraftRequest{UserId: 2, ArticleId: 1, PublishTime: later},
+			wantState:   types.ArticleStatusScheduled,
+			publishTime: later,
+		},
+		{
+			name:        "reschedule",
+			status:      types.ArticleStatusScheduled,
+			content:     "内容",
+			req:         &pb.PublishDraftRequest{UserId: 2, ArticleId: 1, PublishTime: later},
+			wantState:   types.ArticleStatusScheduled,
+			publishTime: later,
+		},
+		{
+			name:      "scheduled publish now",
+			status:    types.ArticleStatusScheduled,
+			content:   "内容",
+			req:       &pb.PublishDraftRequest{UserId: 2, ArticleId: 1},
+			wantState: types.ArticleStatusPending,
+		},
+		{
+			name:      "publish time too late",
+			status:    types.ArticleStatusDraft,
+			content:   "内容",
+			req:       &pb.PublishDraftRequest{UserId: 2, ArticleId: 1, PublishTime: time.Now().Add(2 * types.MaxScheduleDuration).Unix()},
+			err:       code.PublishTimeInvalid,
+			wantState: types.ArticleStatusDraft,
+		},
+		{
+			name:      "not draft",
+			status:    types.ArticleStatusVisible,
+			content:   "内容",
+			req:       &pb.PublishDraftRequest{UserId: 2, ArticleId: 1},
+			err:       code.ArticleNotDraft,
+			wantState: types.ArticleStatusVisible,
+		},
+		{
+			name:      "not author",
+			status:    types.ArticleStatusDraft,
+			content:   "内容",
+			req:       &pb.PublishDraftRequest{UserId: 3, ArticleId: 1},
+			err:       xcode.AccessDenied,
+			wantState: types.ArticleStatusDraft,
+		},
+		{
+			name:      "empty content",
+			status:    types.ArticleStatusDraft,
+			req:       &pb.PublishDraftRequest{UserId: 2, ArticleId: 1},
+			err:       code.ArticleContentCantEmpty,
+			wantState: types.ArticleStatusDraft,
+		},
+		{
+			name:      "sensitive",
+			status:    types.ArticleStatusDraft,
+			content:   "网络赌博",
+			req:       &pb.PublishDraftRequest{UserId: 2, ArticleId: 1},
+			err:       code.ArticleHasSensitiveWord,
+			wantState: types.ArticleStatusDraft,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			articles := newFakeArticleModel(&model.Article{Id: 1, AuthorId: 2, Title: "标题", Content: tt.content, Status: tt.status})
+			l := NewPublishDraftLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeReject))
+
+			_, err := l.PublishDraft(tt.req)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			article := articles.articles[1]
+			if article.Status != tt.wantState {
+				t.Errorf("status = %d, want %d", article.Status, tt.wantState)
+			}
+			if tt.publishTime > 0 && article.PublishTime.Unix() != tt.publishTime {
+				t.Errorf("publish time = %d, want %d", article.PublishTime.Unix(), tt.publishTime)
+			}
+		})
+	}
+}
+
+// 读取之后定时任务已经发布，按原状态修改失败，不会重复发布
+func TestPublishDraftConcurrentScheduler(t *testing.T) {
+	articles := newFakeArticleModel(&model.Article{Id: 1, AuthorId: 2, Title: "标题", Content: "内容", Status: types.ArticleStatusScheduled})
+	articles.beforeSetStatus = func() {
+		articles.articles[1].Status = types.ArticleStatusPending
+	}
+	l := NewPublishDraftLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeReject))
+
+	_, err := l.PublishDraft(&pb.PublishDraftRequest{UserId: 2, ArticleId: 1, PublishTime: time.Now().Add(time.Hour).Unix()})
+	if !errors.Is(err, code.ArticleNotDraft) {
+		t.Fatalf("err = %v, want %v", err, code.ArticleNotDraft)
+	}
+	if article := articles.articles[1]; article.Status != types.ArticleStatusPending {
+		t.Errorf("status = %d", article.Status)
+	}
+}
+
+// audit模式下命中敏感词改为审核不通过并记录原因
+func TestPublishDraftSensitiveAudit(t *testing.T) {
+	articles := newFakeArticleModel(&model.Article{Id: 1, AuthorId: 2, Title: "标题", Content: "网络赌博", Status: types.ArticleStatusDraft})
+	l := NewPublishDraftLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeAudit))
+
+	if _, err := l.PublishDraft(&pb.PublishDraftRequest{UserId: 2, ArticleId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if article := articles.articles[1]; article.Status != types.ArticleStatusNotPass {
+		t.Errorf("status = %d", article.Status)
+	}
+	if len(articles.audits) != 1 || articles.audits[0].Reason != "包含敏感词：赌博" {
+		t.Errorf("audits = %+v", articles.audits)
+	}
+}
@@ -2,7 +2,6 @@ package logic
 
 import (
 	"context"
-	"strconv"
 	"time"
 
 	"myBeyond/application/article/rpc/internal/code"
@@ -29,27 +28,64 @@ func NewPublishLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishLo
 }
 
 func (l *PublishLogic) Publish(in *pb.PublishRequest) (*pb.PublishResponse, error) {
-	// 1、检查参数
+	// 1、检查参数，草稿可以没有内容
 	if in.UserId <= 0 {
 		return nil, code.UserIdInvalid
 	}
 	if len(in.Title) == 0 {
 		return nil, code.ArticleTitleCantEmpty
 	}
-	if len(in.Content) == 0 {
+	if !in.Draft && len(in.Content) == 0 {
 		return nil, code.ArticleContentCantEmpty
 	}
+	// 草稿忽略发布时间，发布草稿时再校验
+	now := time.Now()
+	status, publishTime := types.ArticleStatusDraft, now
+	if !in.Draft {
+		var err error
+		status, publishTime, err = publishStatus(now, in.PublishTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// 草稿在发布时再检查敏感词
+	var words []string
+	if !in.Draft {
+		words = matchSensitive(l.svcCtx, in.Title, in.Description, in.Content)
+	}
+	if len(words) > 0 {
+		l.Logger.Infof("Publish userId: %d hit sensitive words: %v", in.UserId, words)
+		if !sensitiveAuditMode(l.svcCtx) {
+			return nil, code.ArticleHasSensitiveWord
+		}
+		status, publishTime = types.ArticleStatusNotPass, now
+	}
+
 	// 2、插入文章数据
-	ret, err := l.svcCtx.ArticleModel.Insert(l.ctx, &model.Article{
+	article := &model.Article{
 		AuthorId:    in.UserId,
 		Title:       in.Title,
 		Content:     in.Content,
 		Description: in.Description,
 		Cover:       in.Cover,
-		PublishTime: time.Now(),
-		CreateTime:  time.Now(),
-		UpdateTime:  time.Now(),
-	})
+		Status:      int64(status),
+		PublishTime: publishTime,
+		CreateTime:  now,
+		UpdateTime:  now,
+	}
+	// 命中敏感词时在同一个事务中记录审核不通过的原因
+	if len(words) > 0 {
+		audit := sensitiveAudit(article, words)
+		articleId, err := l.svcCtx.ArticleModel.InsertWithAudit(l.ctx, article, audit)
+		if err != nil {
+			l.Logger.Errorf("Publish InsertWithAudit req: %v error: %v", in, err)
+			return nil, err
+		}
+		article.Id = articleId
+		pushAuditMsg(l.svcCtx, article, systemOperatorId, types.ArticleStatusNotPass, audit.Reason)
+		return &pb.PublishResponse{ArticleId: articleId}, nil
+	}
+	ret, err := l.svcCtx.ArticleModel.Insert(l.ctx, article)
 	if err != nil {
 		l.Logger.Errorf("Publish Insert req: %v error: %v", in, err)
 		return nil, err
@@ -62,28 +98,19 @@ func (l *PublishLogic) Publish(in *pb.PublishRequest) (*pb.PublishResponse, erro
 		return nil, err
 	}
 
-	var (
-		articleIdStr   = strconv.FormatInt(articleId, 10)
-		publishTimeKey = articlesKey(in.UserId, types.SortPublishTime)
-		likeNumKey     = articlesKey(in.UserId, types.SortLikeCount)
-	)
+	// 4、审核通过后才写入作者的文章列表，由mq订阅binlog完成
+	return &pb.PublishResponse{ArticleId: articleId}, nil
+}
 
-	//4、将新的文章写缓存列表
-	val, _ := l.svcCtx.BizRedis.ExistsCtx(l.ctx, publishTimeKey)
-	if val {
-		_, err := l.svcCtx.BizRedis.ZaddCtx(l.ctx, publishTimeKey, time.Now().Unix(), articleIdStr)
-		if err != nil {
-			logx.Errorf("ZaddCtx req: %v error: %v", in, err)
-		}
+// publishStatus 根据请求的发布时间决定立即发布还是定时发布，publishTime为0或已经过去时立即发布
+func publishStatus(now time.Time, publishTime int64) (int, time.Time, error) {
+	if publishTime <= now.Unix() {
+		return types.ArticleStatusPending, now, nil
 	}
-
-	val, _ = l.svcCtx.BizRedis.ExistsCtx(l.ctx, likeNumKey)
-	if val {
-		_, err := l.svcCtx.BizRedis.ZaddCtx(l.ctx, likeNumKey, time.Now().Unix(), articleIdStr)
-		if err != nil {
-			logx.Errorf("ZaddCtx req: %v error: %v", in, err)
-		}
+	t := time.Unix(publishTime, 0)
+	if t.Sub(now) > types.MaxScheduleDuration {
+		return 0, time.Time{}, code.PublishTimeInvalid
 	}
 
-	return &pb.PublishResponse{ArticleId: articleId}, nil
+	return types.ArticleStatusScheduled, t, nil
 }
@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+	"myBeyond/pkg/sensitive"
+)
+
+func newSensitiveServiceContext(t *testing.T, articles *fakeArticleModel, mode string) *svc.ServiceContext {
+	file := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(file, []byte("赌博\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	dict, err := sensitive.NewDict(sensitive.Conf{File: file})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(dict.Close)
+
+	svcCtx := newTestServiceContext(articles)
+	svcCtx.Sensitive = dict
+	svcCtx.Config.SensitiveMode = mode
+	return svcCtx
+}
+
+func TestPublishSensitiveAudit(t *testing.T) {
+	articles := newFakeArticleModel()
+	l := NewPublishLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeAudit))
+
+	resp, err := l.Publish(&pb.PublishRequest{UserId: 2, Title: "标题", Content: "网络赌博"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	article := articles.articles[resp.ArticleId]
+	if article == nil || article.Status != types.ArticleStatusNotPass {
+		t.Fatalf("article = %+v", article)
+	}
+	if len(articles.audits) != 1 {
+		t.Fatalf("audits = %+v", articles.audits)
+	}
+	audit := articles.audits[0]
+	if audit.ArticleId != resp.ArticleId || audit.AuthorId != 2 || audit.OperatorId != systemOperatorId ||
+		audit.Status != types.ArticleStatusNotPass || audit.Reason != "包含敏感词：赌博" {
+		t.Errorf("audit = %+v", audit)
+	}
+}
+
+// 插入文章或审核记录失败时返回错误，不能只保存文章
+func TestPublishSensitiveAuditError(t *testing.T) {
+	dbErr := errors.New("insert audit failed")
+	articles := newFakeArticleModel()
+	articles.err = dbErr
+	l := NewPublishLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeAudit))
+
+	if _, err := l.Publish(&pb.PublishRequest{UserId: 2, Title: "标题", Content: "网络赌博"}); !errors.Is(err, dbErr) {
+		t.Fatalf("err = %v, want %v", err, dbErr)
+	}
+	if len(articles.articles) != 0 || len(articles.audits) != 0 {
+		t.Errorf("articles = %v, audits = %v", articles.articles, articles.audits)
+	}
+}
+
+func TestPublishSensitiveReject(t *testing.T) {
+	articles := newFakeArticleModel()
+	l := NewPublishLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeReject))
+
+	if _, err := l.Publish(&pb.PublishRequest{UserId: 2, Title: "标题", Content: "网络赌博"}); !errors.Is(err, code.ArticleHasSensitiveWord) {
+		t.Fatalf("err = %v", err)
+	}
+	if len(articles.articles) != 0 {
+		t.Errorf("articles = %v", articles.articles)
+	}
+}
+
+// 草稿不校验发布时间，发布草稿时再校验
+func TestPublishDraftIgnoresPublishTime(t *testing.T) {
+	articles := newFakeArticleModel()
+	l := NewPublishLogic(context.Background(), newSensitiveServiceContext(t, articles, types.SensitiveModeAudit))
+	farFuture := time.Now().Add(2 * types.MaxScheduleDuration).Unix()
+
+	resp, err := l.Publish(&pb.PublishRequest{UserId: 2, Title: "标题", Draft: true, PublishTime: farFuture})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if article := articles.articles[resp.ArticleId]; article.Status != types.ArticleStatusDraft {
+		t.Errorf("status = %d", article.Status)
+	}
+
+	_, err = l.Publish(&pb.PublishRequest{UserId: 2, Title: "标题", Content: "内容", PublishTime: farFuture})
+	if !errors.Is(err, code.PublishTimeInvalid) {
+		t.Errorf("err = %v, want %v", err, code.PublishTimeInvalid)
+	}
+}
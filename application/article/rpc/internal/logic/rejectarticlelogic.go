@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RejectArticleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRejectArticleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RejectArticleLogic {
+	return &RejectArticleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *RejectArticleLogic) RejectArticle(in *pb.RejectArticleRequest) (*pb.RejectArticleResponse, error) {
+	// 1、审核不通过必须填写原因
+	reason := strings.TrimSpace(in.Reason)
+	if len(reason) == 0 || utf8.RuneCountInString(reason) > types.MaxAuditReasonLen {
+		return nil, code.AuditReasonInvalid
+	}
+
+	// 2、待审核和已可见的文章可以改为审核不通过，已可见的文章会从列表中移除
+	err := auditArticle(l.ctx, l.svcCtx, in.OperatorId, in.ArticleId,
+		[]int{types.ArticleStatusPending, types.ArticleStatusVisible}, types.ArticleStatusNotPass, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectArticleResponse{}, nil
+}
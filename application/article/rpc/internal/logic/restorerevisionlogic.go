@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreRevisionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRestoreRevisionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreRevisionLogic {
+	return &RestoreRevisionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *RestoreRevisionLogic) RestoreRevision(in *pb.RestoreRevisionRequest) (*pb.RestoreRevisionResponse, error) {
+	// 1、只有作者可以恢复
+	article, err := findAuthorArticle(l.ctx, l.svcCtx, in.UserId, in.ArticleId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2、修改历史必须属于该文章
+	revision, err := l.svcCtx.RevisionModel.FindOne(l.ctx, in.RevisionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.RevisionNotExist
+		}
+		l.Logger.Errorf("FindOne revisionId: %d error: %v", in.RevisionId, err)
+		return nil, err
+	}
+	if revision.ArticleId != article.Id {
+		return nil, code.RevisionNotExist
+	}
+
+	// 3、与修改文章相同，恢复的内容同样检查敏感词
+	audit, err := reviseSensitive(l.ctx, l.svcCtx, article, revision.Title, revision.Description, revision.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4、按修改文章处理，当前内容保存为新的修改历史，恢复操作本身也可以撤销
+	err = updateArticle(l.ctx, l.svcCtx, article, &model.Article{
+		Id:          article.Id,
+		Title:       revision.Title,
+		Content:     revision.Content,
+		Description: revision.Description,
+		Cover:       revision.Cover,
+	}, audit)
+	if err != nil {
+		l.Logger.Errorf("UpdateWithRevision req: %v error: %v", in, err)
+		return nil, err
+	}
+
+	return &pb.RestoreRevisionResponse{}, nil
+}
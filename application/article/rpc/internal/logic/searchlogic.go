@@ -0,0 +1,145 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/search"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+	"myBeyond/application/article/rpc/types/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SearchLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSearchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchLogic {
+	return &SearchLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *SearchLogic) Search(in *pb.SearchRequest) (*pb.SearchResponse, error) {
+	// 1、检查参数并赋上默认值
+	keyword := strings.TrimSpace(in.Keyword)
+	if len(keyword) == 0 || utf8.RuneCountInString(keyword) > types.MaxSearchKeywordLen {
+		return nil, code.SearchKeywordInvalid
+	}
+	sortType := int(in.SortType)
+	if sortType != search.SortRelevance && sortType != search.SortLikeCount && sortType != search.SortPublishTime {
+		return nil, code.SortTypeInvalid
+	}
+	if in.Page <= 0 {
+		in.Page = 1
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultSearchPageSize
+	}
+	if in.PageSize > types.MaxSearchPageSize {
+		in.PageSize = types.MaxSearchPageSize
+	}
+	from := (in.Page - 1) * in.PageSize
+	if from+in.PageSize > types.MaxSearchWindow {
+		return nil, code.SearchPageInvalid
+	}
+	statuses, err := searchStatuses(in)
+	if err != nil {
+		return nil, err
+	}
+	if in.AuthorId > 0 {
+		blocked, err := isAuthorBlocked(l.ctx, l.svcCtx, in.ViewerId, in.AuthorId)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return &pb.SearchResponse{IsEnd: true}, nil
+		}
+	}
+
+	// 2、查询es
+	ret, err := l.svcCtx.Searcher.Search(l.ctx, &search.Query{
+		Keyword:  keyword,
+		AuthorId: in.AuthorId,
+		Statuses: statuses,
+		SortType: sortType,
+		From:     int(from),
+		Size:     int(in.PageSize),
+	})
+	if err != nil {
+		l.Logger.Errorf("Search req: %v error: %v", in, err)
+		return nil, err
+	}
+
+	// 3、过滤与当前用户存在拉黑关系的作者，当前页可能少于pageSize
+	authorIds := make([]int64, 0, len(ret.Hits))
+	for _, h := range ret.Hits {
+		authorIds = append(authorIds, h.AuthorId)
+	}
+	blocked, err := blockedAuthors(l.ctx, l.svcCtx, in.ViewerId, authorIds)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*pb.SearchItem, 0, len(ret.Hits))
+	for _, h := range ret.Hits {
+		if _, ok := blocked[h.AuthorId]; ok {
+			continue
+		}
+		items = append(items, &pb.SearchItem{
+			ArticleId:      h.ArticleId,
+			Title:          h.Title,
+			TitleHighlight: h.TitleHighlight,
+			Description:    h.Description,
+			Cover:          h.Cover,
+			AuthorId:       h.AuthorId,
+			AuthorName:     h.AuthorName,
+			LikeCount:      h.LikeNum,
+			PublishTime:    parsePublishTime(h.PublishTime),
+			Snippets:       h.Snippets,
+		})
+	}
+
+	return &pb.SearchResponse{
+		Articles: items,
+		Total:    ret.Total,
+		IsEnd:    from+int64(len(ret.Hits)) >= ret.Total || from+in.PageSize >= types.MaxSearchWindow,
+	}, nil
+}
+
+// searchStatuses 读者只能搜索可见的文章，作者搜索自己的文章时可以指定待审核和审核不通过
+func searchStatuses(in *pb.SearchRequest) ([]int, error) {
+	if len(in.Statuses) == 0 {
+		return visibleStatuses, nil
+	}
+	if in.ViewerId <= 0 || in.AuthorId != in.ViewerId {
+		return nil, code.ArticleStatusInvalid
+	}
+
+	statuses := make([]int, 0, len(in.Statuses))
+	for _, s := range in.Statuses {
+		if !containsStatus(authorStatuses, int(s)) {
+			return nil, code.ArticleStatusInvalid
+		}
+		statuses = append(statuses, int(s))
+	}
+	return statuses, nil
+}
+
+// parsePublishTime es中的发布时间与canal同步的格式一致，解析失败时返回0
+func parsePublishTime(s string) int64 {
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", s, time.Local)
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
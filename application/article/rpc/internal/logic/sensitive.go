@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"myBeyond/application/article/rpc/internal/code"
+	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/internal/svc"
+	"myBeyond/application/article/rpc/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// systemOperatorId 命中敏感词自动审核不通过时记录的审核人
+const systemOperatorId = 0
+
+// matchSensitive 检查标题、描述和内容，返回命中的敏感词
+func matchSensitive(svcCtx *svc.ServiceContext, title, description, content string) []string {
+	return svcCtx.Sensitive.Match(strings.Join([]string{title, description, content}, "\n"))
+}
+
+// sensitiveAuditMode 命中敏感词时是否保存为审核不通过，否则拒绝发布
+func sensitiveAuditMode(svcCtx *svc.ServiceContext) bool {
+	return svcCtx.Config.SensitiveMode == types.SensitiveModeAudit
+}
+
+// sensitiveReason 审核不通过的原因，记录命中的词，超长时截断
+func sensitiveReason(words []string) string {
+	reason := []rune("包含敏感词：" + strings.Join(words, "、"))
+	if len(reason) > types.MaxAuditReasonLen {
+		reason = reason[:types.MaxAuditReasonLen]
+	}
+	return string(reason)
+}
+
+// sensitiveAudit 命中敏感词自动审核不通过时的审核记录
+func sensitiveAudit(article *model.Article, words []string) *model.ArticleAudit {
+	return &model.ArticleAudit{
+		ArticleId:  article.Id,
+		AuthorId:   article.AuthorId,
+		OperatorId: systemOperatorId,
+		Status:     types.ArticleStatusNotPass,
+		Reason:     sensitiveReason(words),
+	}
+}
+
+// reviseSensitive 修改或恢复文章前检查新的内容，草稿在发布时再检查
+// 命中敏感词时拒绝，audit模式下返回审核不通过的记录，与修改在同一个事务中写入
+func reviseSensitive(ctx context.Context, svcCtx *svc.ServiceContext, article *model.Article, title, description, content string) (*model.ArticleAudit, error) {
+	if article.Status == types.ArticleStatusDraft {
+		return nil, nil
+	}
+	words := matchSensitive(svcCtx, title, description, content)
+	if len(words) == 0 {
+		return nil, nil
+	}
+	logx.WithContext(ctx).Infof("articleId: %d hit sensitive words: %v", article.Id, words)
+	if !sensitiveAuditMode(svcCtx) {
+		return nil, code.ArticleHasSensitiveWord
+	}
+
+	return sensitiveAudit(article, words), nil
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ArticleAuditModel = (*customArticleAuditModel)(nil)
+
+type (
+	// ArticleAuditModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customArticleAuditModel.
+	ArticleAuditModel interface {
+		articleAuditModel
+		FindLatestByArticleId(ctx context.Context, articleId int64) (*ArticleAudit, error)
+	}
+
+	customArticleAuditModel struct {
+		*defaultArticleAuditModel
+	}
+)
+
+// NewArticleAuditModel returns a model for the database table.
+func NewArticleAuditModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ArticleAuditModel {
+	return &customArticleAuditModel{
+		defaultArticleAuditModel: newArticleAuditModel(conn, c, opts...),
+	}
+}
+
+// FindLatestByArticleId 查询文章最近一次的审核记录
+func (m *customArticleAuditModel) FindLatestByArticleId(ctx context.Context, articleId int64) (*ArticleAudit, error) {
+	var resp ArticleAudit
+	query := fmt.Sprintf("select %s from %s where `article_id` = ? order by `id` desc limit 1", articleAuditRows, m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &resp, query, articleId)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
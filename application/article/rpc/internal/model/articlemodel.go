@@ -5,6 +5,9 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -17,8 +20,16 @@ type (
 	// and implement the added methods in customArticleModel.
 	ArticleModel interface {
 		articleModel
-		ArticlesByUserId(ctx context.Context, userId, sortField string, offset, limit int) ([]*Article, error)
+		ArticlesByUserId(ctx context.Context, userId, sortField string, statuses []int, offset, limit int) ([]*Article, error)
 		UpdateArticleStatus(ctx context.Context, id int64, status int) error
+		FindIdsByAuthorId(ctx context.Context, authorId, lastId int64, excludeStatus, limit int) ([]int64, error)
+		UpdateWithRevision(ctx context.Context, data *Article, from []int, to int, audit *ArticleAudit) error
+		FindByAuthorAndStatus(ctx context.Context, authorId int64, statuses []int, cursor int64, limit int) ([]*Article, error)
+		FindDue(ctx context.Context, status int, now time.Time, limit int) ([]*Article, error)
+		CompareAndSetStatus(ctx context.Context, id int64, from, to int, publishTime time.Time) (bool, error)
+		Audit(ctx context.Context, from int, data *ArticleAudit) (bool, error)
+		InsertWithAudit(ctx context.Context, data *Article, audit *ArticleAudit) (int64, error)
+		FindByStatus(ctx context.Context, status int, cursor int64, limit int) ([]*Article, error)
 	}
 
 	customArticleModel struct {
@@ -33,10 +44,16 @@ func NewArticleModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option)
 	}
 }
 
-func (m *customArticleModel) ArticlesByUserId(ctx context.Context, userId, sortField string, offset, limit int) ([]*Article, error) {
+// ArticlesByUserId 只返回statuses中状态的文章，草稿和定时发布的文章不出现在列表中
+func (m *customArticleModel) ArticlesByUserId(ctx context.Context, userId, sortField string, statuses []int, offset, limit int) ([]*Article, error) {
 	var articles []*Article
-	sql := fmt.Sprintf("select " + articleRows + " from " + m.table + " where author_id = ? order by ? desc limit ?,?")
-	err := m.QueryRowsNoCacheCtx(ctx, &articles, sql, userId, sortField, offset, limit)
+	sql := fmt.Sprintf("select " + articleRows + " from " + m.table + " where author_id = ? and status in (" + placeholders(len(statuses)) + ") order by ? desc limit ?,?")
+	args := []any{userId}
+	for _, status := range statuses {
+		args = append(args, status)
+	}
+	args = append(args, sortField, offset, limit)
+	err := m.QueryRowsNoCacheCtx(ctx, &articles, sql, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -53,3 +70,162 @@ func (m *customArticleModel) UpdateArticleStatus(ctx context.Context, id int64,
 		}, beyondArticleArticleIdKey)
 	return err
 }
+
+// FindIdsByAuthorId 按id顺序分批查询作者的文章id，跳过excludeStatus状态的文章
+func (m *customArticleModel) FindIdsByAuthorId(ctx context.Context, authorId, lastId int64, excludeStatus, limit int) ([]int64, error) {
+	var ids []int64
+	query := fmt.Sprintf("select `id` from %s where `author_id` = ? and `id` > ? and `status` != ? order by `id` limit ?", m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &ids, query, authorId, lastId, excludeStatus, limit)
+	return ids, err
+}
+
+// UpdateWithRevision 修改文章的标题、内容、封面和描述，修改前的内容在同一个事务中写入article_revision
+// 读取旧内容时加行锁，并发修改时每条历史记录都是上一次修改的结果
+// 修改前状态在from中时同时改为to，按加锁读取的状态判断，不会覆盖并发的审核结果
+// audit不为nil时状态改为审核结果，并在同一个事务中写入审核记录
+func (m *customArticleModel) UpdateWithRevision(ctx context.Context, data *Article, from []int, to int, audit *ArticleAudit) error {
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		var old Article
+		query := fmt.Sprintf("select %s from %s where `id` = ? limit 1 for update", articleRows, m.table)
+		if err := session.QueryRowCtx(ctx, &old, query, data.Id); err != nil {
+			return err
+		}
+
+		query = fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", "`article_revision`", articleRevisionRowsExpectAutoSet)
+		if _, err := session.ExecCtx(ctx, query, old.Id, old.Title, old.Content, old.Cover, old.Description); err != nil {
+			return err
+		}
+
+		status := old.Status
+		for _, s := range from {
+			if old.Status == int64(s) {
+				status = int64(to)
+				break
+			}
+		}
+		if audit != nil {
+			status = audit.Status
+			query = fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", "`article_audit`", articleAuditRowsExpectAutoSet)
+			if _, err := session.ExecCtx(ctx, query, audit.ArticleId, audit.AuthorId, audit.OperatorId, audit.Status, audit.Reason); err != nil {
+				return err
+			}
+		}
+		query = fmt.Sprintf("update %s set `title` = ?, `content` = ?, `cover` = ?, `description` = ?, `status` = ? where `id` = ?", m.table)
+		_, err := session.ExecCtx(ctx, query, data.Title, data.Content, data.Cover, data.Description, status, data.Id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheBeyondArticleArticleIdPrefix, data.Id))
+}
+
+// FindByAuthorAndStatus 按id倒序分页查询作者指定状态的文章，cursor为上一页最后一条的id，第一页传0
+func (m *customArticleModel) FindByAuthorAndStatus(ctx context.Context, authorId int64, statuses []int, cursor int64, limit int) ([]*Article, error) {
+	if cursor <= 0 {
+		cursor = math.MaxInt64
+	}
+	args := []any{authorId}
+	for _, status := range statuses {
+		args = append(args, status)
+	}
+	args = append(args, cursor, limit)
+
+	var articles []*Article
+	query := fmt.Sprintf("select %s from %s where `author_id` = ? and `status` in (%s) and `id` < ? order by `id` desc limit ?",
+		articleRows, m.table, placeholders(len(statuses)))
+	err := m.QueryRowsNoCacheCtx(ctx, &articles, query, args...)
+	return articles, err
+}
+
+// FindDue 查询publish_time已经到期的指定状态的文章，按publish_time顺序
+func (m *customArticleModel) FindDue(ctx context.Context, status int, now time.Time, limit int) ([]*Article, error) {
+	var articles []*Article
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `publish_time` <= ? order by `publish_time` limit ?", articleRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &articles, query, status, now, limit)
+	return articles, err
+}
+
+// CompareAndSetStatus 状态为from时才修改为to，同时修改发布时间，返回是否修改成功
+func (m *customArticleModel) CompareAndSetStatus(ctx context.Context, id int64, from, to int, publishTime time.Time) (bool, error) {
+	beyondArticleArticleIdKey := fmt.Sprintf("%s%v", cacheBeyondArticleArticleIdPrefix, id)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ?, `publish_time` = ? where `id` = ? and `status` = ?", m.table)
+		return conn.ExecCtx(ctx, query, to, publishTime, id, from)
+	}, beyondArticleArticleIdKey)
+	if err != nil {
+		return false, err
+	}
+	rows, err := ret.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// Audit 文章状态为from时修改为审核结果，并在同一个事务中写入审核记录，返回是否修改成功
+func (m *customArticleModel) Audit(ctx context.Context, from int, data *ArticleAudit) (bool, error) {
+	var ok bool
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table)
+		ret, err := session.ExecCtx(ctx, query, data.Status, data.ArticleId, from)
+		if err != nil {
+			return err
+		}
+		rows, err := ret.RowsAffected()
+		if err != nil || rows == 0 {
+			return err
+		}
+
+		query = fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", "`article_audit`", articleAuditRowsExpectAutoSet)
+		if _, err = session.ExecCtx(ctx, query, data.ArticleId, data.AuthorId, data.OperatorId, data.Status, data.Reason); err != nil {
+			return err
+		}
+		ok = true
+		return nil
+	})
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return true, m.DelCacheCtx(ctx, fmt.Sprintf("%s%v", cacheBeyondArticleArticleIdPrefix, data.ArticleId))
+}
+
+// InsertWithAudit 在同一个事务中插入文章和审核记录，审核记录的article_id为插入的文章id，返回文章id
+func (m *customArticleModel) InsertWithAudit(ctx context.Context, data *Article, audit *ArticleAudit) (int64, error) {
+	var id int64
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, articleRowsExpectAutoSet)
+		ret, err := session.ExecCtx(ctx, query, data.Title, data.Content, data.Cover, data.Description, data.AuthorId, data.Status,
+			data.CommentNum, data.LikeNum, data.CollectNum, data.ViewNum, data.ShareNum, data.TagIds, data.PublishTime)
+		if err != nil {
+			return err
+		}
+		if id, err = ret.LastInsertId(); err != nil {
+			return err
+		}
+
+		query = fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", "`article_audit`", articleAuditRowsExpectAutoSet)
+		_, err = session.ExecCtx(ctx, query, id, audit.AuthorId, audit.OperatorId, audit.Status, audit.Reason)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// FindByStatus 按id顺序分页查询指定状态的文章，先提交的先审核，cursor为上一页最后一条的id，第一页传0
+func (m *customArticleModel) FindByStatus(ctx context.Context, status int, cursor int64, limit int) ([]*Article, error) {
+	var articles []*Article
+	query := fmt.Sprintf("select %s from %s where `status` = ? and `id` > ? order by `id` limit ?", articleRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &articles, query, status, cursor, limit)
+	return articles, err
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
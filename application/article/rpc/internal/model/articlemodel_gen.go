@@ -45,7 +45,7 @@ type (
 		Cover       string    `db:"cover"`
 		Description string    `db:"description"`
 		AuthorId    int64     `db:"author_id"` // ID
-		Status      int64     `db:"status"`    //  0: 1: 2: 3:
+		Status      int64     `db:"status"`    //  0: 1: 2: 3: 4: 5:
 		CommentNum  int64     `db:"comment_num"`
 		LikeNum     int64     `db:"like_num"`
 		CollectNum  int64     `db:"collect_num"`
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ ArticleRevisionModel = (*customArticleRevisionModel)(nil)
+
+type (
+	// ArticleRevisionModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customArticleRevisionModel.
+	ArticleRevisionModel interface {
+		articleRevisionModel
+		FindByArticleId(ctx context.Context, articleId, cursor int64, limit int) ([]*ArticleRevision, error)
+	}
+
+	customArticleRevisionModel struct {
+		*defaultArticleRevisionModel
+	}
+)
+
+// NewArticleRevisionModel returns a model for the database table.
+func NewArticleRevisionModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) ArticleRevisionModel {
+	return &customArticleRevisionModel{
+		defaultArticleRevisionModel: newArticleRevisionModel(conn, c, opts...),
+	}
+}
+
+// FindByArticleId 按id倒序分页查询文章的修改历史，cursor为上一页最后一条的id，第一页传0
+func (m *customArticleRevisionModel) FindByArticleId(ctx context.Context, articleId, cursor int64, limit int) ([]*ArticleRevision, error) {
+	var revisions []*ArticleRevision
+	if cursor <= 0 {
+		query := fmt.Sprintf("select %s from %s where `article_id` = ? order by `id` desc limit ?", articleRevisionRows, m.table)
+		err := m.QueryRowsNoCacheCtx(ctx, &revisions, query, articleId, limit)
+		return revisions, err
+	}
+
+	query := fmt.Sprintf("select %s from %s where `article_id` = ? and `id` < ? order by `id` desc limit ?", articleRevisionRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &revisions, query, articleId, cursor, limit)
+	return revisions, err
+}
@@ -0,0 +1,192 @@
+// Package search 在article mq同步的es索引中全文搜索文章。
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+
+	"myBeyond/pkg/es"
+)
+
+// 排序方式
+const (
+	SortRelevance = iota
+	SortLikeCount
+	SortPublishTime
+)
+
+const (
+	// DefaultIndex 与article mq写入的索引一致
+	DefaultIndex = "article-index"
+
+	highlightPreTag  = "<em>"
+	highlightPostTag = "</em>"
+	snippetSize      = 100
+	maxSnippets      = 3
+)
+
+// 参与匹配的字段和权重
+var matchFields = []string{"title^3", "author_name^2", "description^2", "content"}
+
+type (
+	Query struct {
+		Keyword  string
+		AuthorId int64 // 0表示不限
+		Statuses []int // 为空时不过滤
+		SortType int
+		From     int
+		Size     int
+	}
+
+	Hit struct {
+		ArticleId   int64  `json:"article_id"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Cover       string `json:"cover"`
+		AuthorId    int64  `json:"author_id"`
+		AuthorName  string `json:"author_name"`
+		Status      int    `json:"status"`
+		LikeNum     int64  `json:"like_num"`
+		PublishTime string `json:"publish_time"`
+		// 标题中的关键词用<em>标记，没有命中标题时为原标题，都已经过html转义
+		TitleHighlight string `json:"-"`
+		// 描述和内容中命中关键词的片段，已经过html转义
+		Snippets []string `json:"-"`
+	}
+
+	Result struct {
+		Total int64
+		Hits  []*Hit
+	}
+
+	Searcher struct {
+		client *es.Es
+		index  string
+	}
+)
+
+func NewSearcher(client *es.Es, index string) *Searcher {
+	if len(index) == 0 {
+		index = DefaultIndex
+	}
+	return &Searcher{client: client, index: index}
+}
+
+// Search 按Query构建查询，返回当前页的结果和匹配的总数
+func (s *Searcher) Search(ctx context.Context, q *Query) (*Result, error) {
+	body, err := json.Marshal(buildQuery(q))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Search(
+		s.client.Search.WithContext(ctx),
+		s.client.Search.WithIndex(s.index),
+		s.client.Search.WithBody(bytes.NewReader(body)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		data, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("es search status: %d body: %s", resp.StatusCode, data)
+	}
+
+	var ret searchResponse
+	if err = json.NewDecoder(resp.Body).Decode(&ret); err != nil {
+		return nil, err
+	}
+
+	return ret.result(), nil
+}
+
+func buildQuery(q *Query) map[string]any {
+	filter := make([]any, 0, 2)
+	if len(q.Statuses) > 0 {
+		filter = append(filter, map[string]any{"terms": map[string]any{"status": q.Statuses}})
+	}
+	if q.AuthorId > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"author_id": q.AuthorId}})
+	}
+
+	var sort []any
+	switch q.SortType {
+	case SortLikeCount:
+		sort = []any{map[string]any{"like_num": "desc"}, "_score", map[string]any{"article_id": "desc"}}
+	case SortPublishTime:
+		sort = []any{map[string]any{"publish_time": "desc"}, "_score", map[string]any{"article_id": "desc"}}
+	default:
+		sort = []any{"_score", map[string]any{"publish_time": "desc"}}
+	}
+
+	return map[string]any{
+		"from": q.From,
+		"size": q.Size,
+		"query": map[string]any{
+			"bool": map[string]any{
+				"must": []any{map[string]any{
+					// 索引使用standard分词，中文按字切分，要求所有词都命中，避免只命中单个字的结果
+					"multi_match": map[string]any{
+						"query":    q.Keyword,
+						"fields":   matchFields,
+						"operator": "and",
+					},
+				}},
+				"filter": filter,
+			},
+		},
+		"sort":         sort,
+		"track_scores": true,
+		"_source":      []string{"article_id", "title", "description", "cover", "author_id", "author_name", "status", "like_num", "publish_time"},
+		// 高亮片段来自用户输入的内容，由es转义后再插入标签
+		"highlight": map[string]any{
+			"encoder":   "html",
+			"pre_tags":  []string{highlightPreTag},
+			"post_tags": []string{highlightPostTag},
+			"fields": map[string]any{
+				"title":       map[string]any{"number_of_fragments": 0},
+				"description": map[string]any{"fragment_size": snippetSize, "number_of_fragments": 1},
+				"content":     map[string]any{"fragment_size": snippetSize, "number_of_fragments": maxSnippets},
+			},
+		},
+	}
+}
+
+type searchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Source    Hit                 `json:"_source"`
+			Highlight map[string][]string `json:"highlight"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+func (r *searchResponse) result() *Result {
+	ret := &Result{
+		Total: r.Hits.Total.Value,
+		Hits:  make([]*Hit, 0, len(r.Hits.Hits)),
+	}
+	for _, h := range r.Hits.Hits {
+		hit := h.Source
+		hit.TitleHighlight = html.EscapeString(hit.Title)
+		if titles := h.Highlight["title"]; len(titles) > 0 {
+			hit.TitleHighlight = titles[0]
+		}
+		hit.Snippets = append(hit.Snippets, h.Highlight["description"]...)
+		hit.Snippets = append(hit.Snippets, h.Highlight["content"]...)
+		if len(hit.Snippets) > maxSnippets {
+			hit.Snippets = hit.Snippets[:maxSnippets]
+		}
+		ret.Hits = append(ret.Hits, &hit)
+	}
+
+	return ret
+}
@@ -0,0 +1,169 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"myBeyond/pkg/es"
+)
+
+// 录制的es响应
+const recordedResponse = `{
+  "took": 3,
+  "timed_out": false,
+  "hits": {
+    "total": {"value": 12, "relation": "eq"},
+    "max_score": 2.1,
+    "hits": [
+      {
+        "_index": "article-index",
+        "_id": "7",
+        "_score": 2.1,
+        "_source": {
+          "article_id": 7, "title": "go-zero 微服务实践", "description": "介绍go-zero",
+          "cover": "https://example.com/7.png", "author_id": 3, "author_name": "beyond",
+          "status": 2, "like_num": 42, "publish_time": "2026-10-01 08:00:00"
+        },
+        "highlight": {
+          "title": ["<em>go-zero</em> 微服务实践"],
+          "content": ["使用<em>go-zero</em>搭建服务", "<em>go-zero</em>的缓存"]
+        }
+      },
+      {
+        "_index": "article-index",
+        "_id": "5",
+        "_score": 1.3,
+        "_source": {
+          "article_id": 5, "title": "缓存设计", "author_id": 4, "author_name": "go-zero",
+          "status": 2, "like_num": 8, "publish_time": "2026-09-20 10:30:00"
+        }
+      }
+    ]
+  }
+}`
+
+func newTestSearcher(t *testing.T, handler http.HandlerFunc) *Searcher {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := es.NewEs(&es.Config{Addresses: []string{srv.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewSearcher(client, "")
+}
+
+func TestSearch(t *testing.T) {
+	var body map[string]any
+	s := newTestSearcher(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/article-index/_search" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("invalid body: %s", data)
+		}
+		io.WriteString(w, recordedResponse)
+	})
+
+	ret, err := s.Search(context.Background(), &Query{
+		Keyword:  "go-zero",
+		AuthorId: 3,
+		Statuses: []int{2},
+		SortType: SortLikeCount,
+		From:     10,
+		Size:     10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 请求
+	if body["from"] != float64(10) || body["size"] != float64(10) {
+		t.Fatalf("unexpected paging: %v %v", body["from"], body["size"])
+	}
+	boolQuery := body["query"].(map[string]any)["bool"].(map[string]any)
+	filter, _ := json.Marshal(boolQuery["filter"])
+	if string(filter) != `[{"terms":{"status":[2]}},{"term":{"author_id":3}}]` {
+		t.Fatalf("unexpected filter: %s", filter)
+	}
+	must, _ := json.Marshal(boolQuery["must"])
+	if !strings.Contains(string(must), `"fields":["title^3","author_name^2","description^2","content"]`) ||
+		!strings.Contains(string(must), `"operator":"and"`) {
+		t.Fatalf("unexpected must: %s", must)
+	}
+	if encoder := body["highlight"].(map[string]any)["encoder"]; encoder != "html" {
+		t.Fatalf("unexpected highlight encoder: %v", encoder)
+	}
+	sort, _ := json.Marshal(body["sort"])
+	if !strings.HasPrefix(string(sort), `[{"like_num":"desc"}`) {
+		t.Fatalf("unexpected sort: %s", sort)
+	}
+
+	// 响应
+	if ret.Total != 12 || len(ret.Hits) != 2 {
+		t.Fatalf("unexpected result: %+v", ret)
+	}
+	first := ret.Hits[0]
+	if first.ArticleId != 7 || first.AuthorName != "beyond" || first.LikeNum != 42 {
+		t.Fatalf("unexpected hit: %+v", first)
+	}
+	if first.TitleHighlight != "<em>go-zero</em> 微服务实践" {
+		t.Fatalf("unexpected title highlight: %s", first.TitleHighlight)
+	}
+	if !reflect.DeepEqual(first.Snippets, []string{"使用<em>go-zero</em>搭建服务", "<em>go-zero</em>的缓存"}) {
+		t.Fatalf("unexpected snippets: %v", first.Snippets)
+	}
+	if second := ret.Hits[1]; second.TitleHighlight != "缓存设计" || len(second.Snippets) != 0 {
+		t.Fatalf("unexpected hit without highlight: %+v", second)
+	}
+}
+
+// 标题中的html需要转义，只保留高亮标签
+func TestSearchEscape(t *testing.T) {
+	s := newTestSearcher(t, func(w http.ResponseWriter, r *http.Request) {
+		// 开启encoder为html后es返回转义过的高亮片段
+		io.WriteString(w, `{"hits":{"total":{"value":2},"hits":[
+			{"_source":{"article_id":1,"title":"<script>alert(1)</script> go"},
+			 "highlight":{"title":["&lt;script&gt;alert(1)&lt;&#x2F;script&gt; <em>go</em>"]}},
+			{"_source":{"article_id":2,"title":"<script>alert(2)</script>"}}
+		]}}`)
+	})
+
+	ret, err := s.Search(context.Background(), &Query{Keyword: "go", Size: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ret.Hits[0].TitleHighlight; got != "&lt;script&gt;alert(1)&lt;&#x2F;script&gt; <em>go</em>" {
+		t.Fatalf("unexpected title highlight: %s", got)
+	}
+	// 没有命中标题时原标题同样需要转义
+	if got := ret.Hits[1].TitleHighlight; got != "&lt;script&gt;alert(2)&lt;/script&gt;" {
+		t.Fatalf("unexpected title without highlight: %s", got)
+	}
+	if ret.Hits[1].Title != "<script>alert(2)</script>" {
+		t.Fatalf("title should stay raw: %s", ret.Hits[1].Title)
+	}
+}
+
+func TestSearchError(t *testing.T) {
+	s := newTestSearcher(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"error":{"type":"index_not_found_exception"},"status":404}`)
+	})
+
+	_, err := s.Search(context.Background(), &Query{Keyword: "go-zero", Size: 10})
+	if err == nil || !strings.Contains(err.Error(), "index_not_found_exception") {
+		t.Fatalf("expected index not found error, got %v", err)
+	}
+}
@@ -41,3 +41,53 @@ func (s *ArticleServer) ArticleDetail(ctx context.Context, in *pb.ArticleDetailR
 	l := logic.NewArticleDetailLogic(ctx, s.svcCtx)
 	return l.ArticleDetail(in)
 }
+
+func (s *ArticleServer) DeleteUserArticles(ctx context.Context, in *pb.DeleteUserArticlesRequest) (*pb.DeleteUserArticlesResponse, error) {
+	l := logic.NewDeleteUserArticlesLogic(ctx, s.svcCtx)
+	return l.DeleteUserArticles(in)
+}
+
+func (s *ArticleServer) ArticleUpdate(ctx context.Context, in *pb.ArticleUpdateRequest) (*pb.ArticleUpdateResponse, error) {
+	l := logic.NewArticleUpdateLogic(ctx, s.svcCtx)
+	return l.ArticleUpdate(in)
+}
+
+func (s *ArticleServer) ArticleRevisions(ctx context.Context, in *pb.ArticleRevisionsRequest) (*pb.ArticleRevisionsResponse, error) {
+	l := logic.NewArticleRevisionsLogic(ctx, s.svcCtx)
+	return l.ArticleRevisions(in)
+}
+
+func (s *ArticleServer) RestoreRevision(ctx context.Context, in *pb.RestoreRevisionRequest) (*pb.RestoreRevisionResponse, error) {
+	l := logic.NewRestoreRevisionLogic(ctx, s.svcCtx)
+	return l.RestoreRevision(in)
+}
+
+func (s *ArticleServer) Drafts(ctx context.Context, in *pb.DraftsRequest) (*pb.DraftsResponse, error) {
+	l := logic.NewDraftsLogic(ctx, s.svcCtx)
+	return l.Drafts(in)
+}
+
+func (s *ArticleServer) PublishDraft(ctx context.Context, in *pb.PublishDraftRequest) (*pb.PublishDraftResponse, error) {
+	l := logic.NewPublishDraftLogic(ctx, s.svcCtx)
+	return l.PublishDraft(in)
+}
+
+func (s *ArticleServer) ApproveArticle(ctx context.Context, in *pb.ApproveArticleRequest) (*pb.ApproveArticleResponse, error) {
+	l := logic.NewApproveArticleLogic(ctx, s.svcCtx)
+	return l.ApproveArticle(in)
+}
+
+func (s *ArticleServer) RejectArticle(ctx context.Context, in *pb.RejectArticleRequest) (*pb.RejectArticleResponse, error) {
+	l := logic.NewRejectArticleLogic(ctx, s.svcCtx)
+	return l.RejectArticle(in)
+}
+
+func (s *ArticleServer) ModerationQueue(ctx context.Context, in *pb.ModerationQueueRequest) (*pb.ModerationQueueResponse, error) {
+	l := logic.NewModerationQueueLogic(ctx, s.svcCtx)
+	return l.ModerationQueue(in)
+}
+
+func (s *ArticleServer) Search(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
+	l := logic.NewSearchLogic(ctx, s.svcCtx)
+	return l.Search(in)
+}
@@ -3,15 +3,28 @@ package svc
 import (
 	"myBeyond/application/article/rpc/internal/config"
 	"myBeyond/application/article/rpc/internal/model"
+	"myBeyond/application/article/rpc/internal/search"
+	"myBeyond/application/follow/rpc/follow"
+	"myBeyond/pkg/es"
+	"myBeyond/pkg/interceptors"
+	"myBeyond/pkg/sensitive"
 
+	"github.com/zeromicro/go-queue/kq"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
-	Config       config.Config
-	ArticleModel model.ArticleModel
-	BizRedis     *redis.Redis
+	Config         config.Config
+	ArticleModel   model.ArticleModel
+	RevisionModel  model.ArticleRevisionModel
+	AuditModel     model.ArticleAuditModel
+	BizRedis       *redis.Redis
+	FollowRPC      follow.Follow
+	KqPusherClient *kq.Pusher
+	Sensitive      *sensitive.Dict
+	Searcher       *search.Searcher
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -24,9 +37,20 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		panic(err)
 	}
 
+	conn := sqlx.NewMysql(c.DataSource)
 	return &ServiceContext{
-		Config:       c,
-		ArticleModel: model.NewArticleModel(sqlx.NewMysql(c.DataSource), c.CacheRedis),
-		BizRedis:     rds,
+		Config:         c,
+		ArticleModel:   model.NewArticleModel(conn, c.CacheRedis),
+		RevisionModel:  model.NewArticleRevisionModel(conn, c.CacheRedis),
+		AuditModel:     model.NewArticleAuditModel(conn, c.CacheRedis),
+		BizRedis:       rds,
+		FollowRPC:      follow.NewFollow(zrpc.MustNewClient(c.FollowRPC, zrpc.WithUnaryClientInterceptor(interceptors.ClientErrorInterceptor()))),
+		KqPusherClient: kq.NewPusher(c.KqPusherConf.Brokers, c.KqPusherConf.Topic),
+		Sensitive:      sensitive.MustNewDict(c.Sensitive),
+		Searcher: search.NewSearcher(es.MustNewEs(&es.Config{
+			Addresses: c.Es.Addresses,
+			Username:  c.Es.Username,
+			Password:  c.Es.Password,
+		}), c.Es.Index),
 	}
 }
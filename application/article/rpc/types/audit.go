@@ -0,0 +1,12 @@
+package types
+
+// ArticleAuditMsg 审核结果消息，由article mq消费后通知作者
+type ArticleAuditMsg struct {
+	ArticleId  int64  `json:"articleId,omitempty"`
+	AuthorId   int64  `json:"authorId,omitempty"`
+	Title      string `json:"title,omitempty"`
+	Status     int    `json:"status,omitempty"` // 审核结果 1:审核不通过 2:可见
+	Reason     string `json:"reason,omitempty"`
+	AuditTime  int64  `json:"auditTime,omitempty"`
+	OperatorId int64  `json:"operatorId,omitempty"`
+}
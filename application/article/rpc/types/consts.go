@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 const (
 	SortPublishTime = iota
 	SortLikeCount
@@ -10,6 +12,34 @@ const (
 	DefaultLimit    = 5
 
 	DefaultSortLikeCursor = 1 << 30
+
+	DefaultRevisionPageSize = 10
+	MaxRevisionPageSize     = 50
+
+	DefaultDraftPageSize = 10
+	MaxDraftPageSize     = 50
+
+	// MaxScheduleDuration 定时发布最多可以提前多久设置
+	MaxScheduleDuration = 30 * 24 * time.Hour
+
+	// MaxArticlesRefill 缓存的文章列表中有不可见的文章时，最多移除后重新读取几次
+	MaxArticlesRefill = 3
+
+	DefaultModerationPageSize = 20
+	MaxModerationPageSize     = 100
+
+	// MaxAuditReasonLen 审核不通过原因的最大字符数，与article_audit.reason一致
+	MaxAuditReasonLen = 255
+
+	SensitiveModeReject = "reject"
+	SensitiveModeAudit  = "audit"
+
+	DefaultSearchPageSize = 10
+	MaxSearchPageSize     = 50
+	// MaxSearchWindow es默认的max_result_window，from+size不能超过
+	MaxSearchWindow = 10000
+	// MaxSearchKeywordLen 搜索关键词的最大字符数
+	MaxSearchKeywordLen = 64
 )
 
 const (
@@ -21,4 +51,8 @@ const (
 	ArticleStatusVisible
 	// ArticleStatusUserDelete 用户删除
 	ArticleStatusUserDelete
+	// ArticleStatusDraft 草稿
+	ArticleStatusDraft
+	// ArticleStatusScheduled 定时发布，到publish_time后由cmd/scheduler改为待审核
+	ArticleStatusScheduled
 )
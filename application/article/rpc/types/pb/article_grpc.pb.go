@@ -26,6 +26,16 @@ type ArticleClient interface {
 	Articles(ctx context.Context, in *ArticlesRequest, opts ...grpc.CallOption) (*ArticlesResponse, error)
 	ArticleDelete(ctx context.Context, in *ArticleDeleteRequest, opts ...grpc.CallOption) (*ArticleDeleteResponse, error)
 	ArticleDetail(ctx context.Context, in *ArticleDetailRequest, opts ...grpc.CallOption) (*ArticleDetailResponse, error)
+	DeleteUserArticles(ctx context.Context, in *DeleteUserArticlesRequest, opts ...grpc.CallOption) (*DeleteUserArticlesResponse, error)
+	ArticleUpdate(ctx context.Context, in *ArticleUpdateRequest, opts ...grpc.CallOption) (*ArticleUpdateResponse, error)
+	ArticleRevisions(ctx context.Context, in *ArticleRevisionsRequest, opts ...grpc.CallOption) (*ArticleRevisionsResponse, error)
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error)
+	Drafts(ctx context.Context, in *DraftsRequest, opts ...grpc.CallOption) (*DraftsResponse, error)
+	PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error)
+	ApproveArticle(ctx context.Context, in *ApproveArticleRequest, opts ...grpc.CallOption) (*ApproveArticleResponse, error)
+	RejectArticle(ctx context.Context, in *RejectArticleRequest, opts ...grpc.CallOption) (*RejectArticleResponse, error)
+	ModerationQueue(ctx context.Context, in *ModerationQueueRequest, opts ...grpc.CallOption) (*ModerationQueueResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 }
 
 type articleClient struct {
@@ -72,6 +82,96 @@ func (c *articleClient) ArticleDetail(ctx context.Context, in *ArticleDetailRequ
 	return out, nil
 }
 
+func (c *articleClient) DeleteUserArticles(ctx context.Context, in *DeleteUserArticlesRequest, opts ...grpc.CallOption) (*DeleteUserArticlesResponse, error) {
+	out := new(DeleteUserArticlesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/DeleteUserArticles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) ArticleUpdate(ctx context.Context, in *ArticleUpdateRequest, opts ...grpc.CallOption) (*ArticleUpdateResponse, error) {
+	out := new(ArticleUpdateResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/ArticleUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) ArticleRevisions(ctx context.Context, in *ArticleRevisionsRequest, opts ...grpc.CallOption) (*ArticleRevisionsResponse, error) {
+	out := new(ArticleRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/ArticleRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionResponse, error) {
+	out := new(RestoreRevisionResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/RestoreRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) Drafts(ctx context.Context, in *DraftsRequest, opts ...grpc.CallOption) (*DraftsResponse, error) {
+	out := new(DraftsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/Drafts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftResponse, error) {
+	out := new(PublishDraftResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/PublishDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) ApproveArticle(ctx context.Context, in *ApproveArticleRequest, opts ...grpc.CallOption) (*ApproveArticleResponse, error) {
+	out := new(ApproveArticleResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/ApproveArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) RejectArticle(ctx context.Context, in *RejectArticleRequest, opts ...grpc.CallOption) (*RejectArticleResponse, error) {
+	out := new(RejectArticleResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/RejectArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) ModerationQueue(ctx context.Context, in *ModerationQueueRequest, opts ...grpc.CallOption) (*ModerationQueueResponse, error) {
+	out := new(ModerationQueueResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/ModerationQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/pb.Article/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArticleServer is the server API for Article service.
 // All implementations must embed UnimplementedArticleServer
 // for forward compatibility
@@ -80,6 +180,16 @@ type ArticleServer interface {
 	Articles(context.Context, *ArticlesRequest) (*ArticlesResponse, error)
 	ArticleDelete(context.Context, *ArticleDeleteRequest) (*ArticleDeleteResponse, error)
 	ArticleDetail(context.Context, *ArticleDetailRequest) (*ArticleDetailResponse, error)
+	DeleteUserArticles(context.Context, *DeleteUserArticlesRequest) (*DeleteUserArticlesResponse, error)
+	ArticleUpdate(context.Context, *ArticleUpdateRequest) (*ArticleUpdateResponse, error)
+	ArticleRevisions(context.Context, *ArticleRevisionsRequest) (*ArticleRevisionsResponse, error)
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error)
+	Drafts(context.Context, *DraftsRequest) (*DraftsResponse, error)
+	PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error)
+	ApproveArticle(context.Context, *ApproveArticleRequest) (*ApproveArticleResponse, error)
+	RejectArticle(context.Context, *RejectArticleRequest) (*RejectArticleResponse, error)
+	ModerationQueue(context.Context, *ModerationQueueRequest) (*ModerationQueueResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 	mustEmbedUnimplementedArticleServer()
 }
 
@@ -99,6 +209,36 @@ func (UnimplementedArticleServer) ArticleDelete(context.Context, *ArticleDeleteR
 func (UnimplementedArticleServer) ArticleDetail(context.Context, *ArticleDetailRequest) (*ArticleDetailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArticleDetail not implemented")
 }
+func (UnimplementedArticleServer) DeleteUserArticles(context.Context, *DeleteUserArticlesRequest) (*DeleteUserArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserArticles not implemented")
+}
+func (UnimplementedArticleServer) ArticleUpdate(context.Context, *ArticleUpdateRequest) (*ArticleUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArticleUpdate not implemented")
+}
+func (UnimplementedArticleServer) ArticleRevisions(context.Context, *ArticleRevisionsRequest) (*ArticleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArticleRevisions not implemented")
+}
+func (UnimplementedArticleServer) RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedArticleServer) Drafts(context.Context, *DraftsRequest) (*DraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drafts not implemented")
+}
+func (UnimplementedArticleServer) PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedArticleServer) ApproveArticle(context.Context, *ApproveArticleRequest) (*ApproveArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveArticle not implemented")
+}
+func (UnimplementedArticleServer) RejectArticle(context.Context, *RejectArticleRequest) (*RejectArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectArticle not implemented")
+}
+func (UnimplementedArticleServer) ModerationQueue(context.Context, *ModerationQueueRequest) (*ModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerationQueue not implemented")
+}
+func (UnimplementedArticleServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
 func (UnimplementedArticleServer) mustEmbedUnimplementedArticleServer() {}
 
 // UnsafeArticleServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +324,186 @@ func _Article_ArticleDetail_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Article_DeleteUserArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).DeleteUserArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/DeleteUserArticles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).DeleteUserArticles(ctx, req.(*DeleteUserArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_ArticleUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).ArticleUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/ArticleUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).ArticleUpdate(ctx, req.(*ArticleUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_ArticleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).ArticleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/ArticleRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).ArticleRevisions(ctx, req.(*ArticleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/RestoreRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_Drafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).Drafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/Drafts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).Drafts(ctx, req.(*DraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).PublishDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/PublishDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).PublishDraft(ctx, req.(*PublishDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_ApproveArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).ApproveArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/ApproveArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).ApproveArticle(ctx, req.(*ApproveArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_RejectArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).RejectArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/RejectArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).RejectArticle(ctx, req.(*RejectArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_ModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).ModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/ModerationQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).ModerationQueue(ctx, req.(*ModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Article/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Article_ServiceDesc is the grpc.ServiceDesc for Article service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +527,46 @@ var Article_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ArticleDetail",
 			Handler:    _Article_ArticleDetail_Handler,
 		},
+		{
+			MethodName: "DeleteUserArticles",
+			Handler:    _Article_DeleteUserArticles_Handler,
+		},
+		{
+			MethodName: "ArticleUpdate",
+			Handler:    _Article_ArticleUpdate_Handler,
+		},
+		{
+			MethodName: "ArticleRevisions",
+			Handler:    _Article_ArticleRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _Article_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "Drafts",
+			Handler:    _Article_Drafts_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _Article_PublishDraft_Handler,
+		},
+		{
+			MethodName: "ApproveArticle",
+			Handler:    _Article_ApproveArticle_Handler,
+		},
+		{
+			MethodName: "RejectArticle",
+			Handler:    _Article_RejectArticle_Handler,
+		},
+		{
+			MethodName: "ModerationQueue",
+			Handler:    _Article_ModerationQueue_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Article_Search_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "article.proto",
@@ -7,4 +7,8 @@ var (
 	FollowedUserIdEmpty = xcode.New(40002, "被关注用户id为空")
 	CannotFollowSelf    = xcode.New(40003, "不能关注自己")
 	UserIdEmpty         = xcode.New(40004, "用户id为空")
+	BlockedUserIdEmpty  = xcode.New(40005, "被拉黑用户id为空")
+	CannotBlockSelf     = xcode.New(40006, "不能拉黑自己")
+	FollowBlocked       = xcode.New(40007, "存在拉黑关系，无法关注")
+	FindBlockedTooMany  = xcode.New(40008, "批量查询的用户过多")
 )
@@ -13,22 +13,38 @@ import (
 )
 
 type (
-	FansItem           = pb.FansItem
-	FansListRequest    = pb.FansListRequest
-	FansListResponse   = pb.FansListResponse
-	FollowItem         = pb.FollowItem
-	FollowListRequest  = pb.FollowListRequest
-	FollowListResponse = pb.FollowListResponse
-	FollowRequest      = pb.FollowRequest
-	FollowResponse     = pb.FollowResponse
-	UnFollowRequest    = pb.UnFollowRequest
-	UnFollowResponse   = pb.UnFollowResponse
+	BlockItem                   = pb.BlockItem
+	BlockListRequest            = pb.BlockListRequest
+	BlockListResponse           = pb.BlockListResponse
+	BlockRequest                = pb.BlockRequest
+	BlockResponse               = pb.BlockResponse
+	FansItem                    = pb.FansItem
+	FansListRequest             = pb.FansListRequest
+	FansListResponse            = pb.FansListResponse
+	FindBlockedRequest          = pb.FindBlockedRequest
+	FindBlockedResponse         = pb.FindBlockedResponse
+	FollowItem                  = pb.FollowItem
+	FollowListRequest           = pb.FollowListRequest
+	FollowListResponse          = pb.FollowListResponse
+	FollowRequest               = pb.FollowRequest
+	FollowResponse              = pb.FollowResponse
+	RemoveUserRelationsRequest  = pb.RemoveUserRelationsRequest
+	RemoveUserRelationsResponse = pb.RemoveUserRelationsResponse
+	UnBlockRequest              = pb.UnBlockRequest
+	UnBlockResponse             = pb.UnBlockResponse
+	UnFollowRequest             = pb.UnFollowRequest
+	UnFollowResponse            = pb.UnFollowResponse
 
 	Follow interface {
 		Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
 		UnFollow(ctx context.Context, in *UnFollowRequest, opts ...grpc.CallOption) (*UnFollowResponse, error)
 		FollowList(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error)
 		FansList(ctx context.Context, in *FansListRequest, opts ...grpc.CallOption) (*FansListResponse, error)
+		Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+		UnBlock(ctx context.Context, in *UnBlockRequest, opts ...grpc.CallOption) (*UnBlockResponse, error)
+		BlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListResponse, error)
+		FindBlocked(ctx context.Context, in *FindBlockedRequest, opts ...grpc.CallOption) (*FindBlockedResponse, error)
+		RemoveUserRelations(ctx context.Context, in *RemoveUserRelationsRequest, opts ...grpc.CallOption) (*RemoveUserRelationsResponse, error)
 	}
 
 	defaultFollow struct {
@@ -61,3 +77,28 @@ func (m *defaultFollow) FansList(ctx context.Context, in *FansListRequest, opts
 	client := pb.NewFollowClient(m.cli.Conn())
 	return client.FansList(ctx, in, opts...)
 }
+
+func (m *defaultFollow) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.Block(ctx, in, opts...)
+}
+
+func (m *defaultFollow) UnBlock(ctx context.Context, in *UnBlockRequest, opts ...grpc.CallOption) (*UnBlockResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.UnBlock(ctx, in, opts...)
+}
+
+func (m *defaultFollow) BlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.BlockList(ctx, in, opts...)
+}
+
+func (m *defaultFollow) FindBlocked(ctx context.Context, in *FindBlockedRequest, opts ...grpc.CallOption) (*FindBlockedResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.FindBlocked(ctx, in, opts...)
+}
+
+func (m *defaultFollow) RemoveUserRelations(ctx context.Context, in *RemoveUserRelationsRequest, opts ...grpc.CallOption) (*RemoveUserRelationsResponse, error) {
+	client := pb.NewFollowClient(m.cli.Conn())
+	return client.RemoveUserRelations(ctx, in, opts...)
+}
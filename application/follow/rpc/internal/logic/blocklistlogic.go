@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"math"
+
+	"myBeyond/application/follow/code"
+	"myBeyond/application/follow/rpc/internal/svc"
+	"myBeyond/application/follow/rpc/internal/types"
+	"myBeyond/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BlockListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockListLogic {
+	return &BlockListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *BlockListLogic) BlockList(in *pb.BlockListRequest) (*pb.BlockListResponse, error) {
+	// 1、检验参数
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if in.PageSize <= 0 {
+		in.PageSize = types.DefaultPageSize
+	}
+	if in.Cursor <= 0 {
+		in.Cursor = math.MaxInt64
+	}
+
+	// 2、拉黑列表数据量小，直接查询数据库
+	blocks, err := l.svcCtx.BlockModel.FindByUserId(l.ctx, in.UserId, in.Cursor, int(in.PageSize))
+	if err != nil {
+		l.Logger.Errorf("[BlockList] BlockModel.FindByUserId err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	items := make([]*pb.BlockItem, 0, len(blocks))
+	for _, block := range blocks {
+		items = append(items, &pb.BlockItem{
+			Id:            block.ID,
+			BlockedUserId: block.BlockedUserID,
+			CreateTime:    block.CreateTime.Unix(),
+		})
+	}
+	resp := &pb.BlockListResponse{
+		Items: items,
+		IsEnd: len(items) < int(in.PageSize),
+	}
+	if len(items) > 0 {
+		resp.Cursor = items[len(items)-1].Id
+	}
+
+	return resp, nil
+}
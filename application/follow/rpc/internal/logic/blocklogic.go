@@ -0,0 +1,134 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"myBeyond/application/follow/code"
+	"myBeyond/application/follow/rpc/internal/model"
+	"myBeyond/application/follow/rpc/internal/svc"
+	"myBeyond/application/follow/rpc/internal/types"
+	"myBeyond/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"gorm.io/gorm"
+)
+
+type BlockLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockLogic {
+	return &BlockLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *BlockLogic) Block(in *pb.BlockRequest) (*pb.BlockResponse, error) {
+	// 1、校验参数
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if in.BlockedUserId == 0 {
+		return nil, code.BlockedUserIdEmpty
+	}
+	if in.BlockedUserId == in.UserId {
+		return nil, code.CannotBlockSelf
+	}
+
+	block, err := l.svcCtx.BlockModel.FindByUserIDAndBlockedUserID(l.ctx, in.UserId, in.BlockedUserId)
+	if err != nil {
+		l.Logger.Errorf("[Block] BlockModel.FindByUserIDAndBlockedUserID err: %v req: %v", err, in)
+		return nil, err
+	}
+	if block != nil && block.BlockStatus == types.BlockStatusBlock {
+		return &pb.BlockResponse{}, nil
+	}
+
+	// 2、拉黑并双向取消关注，使用事务实现
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		if block != nil {
+			err = model.NewBlockModel(tx).UpdateFields(l.ctx, block.ID, map[string]any{
+				"block_status": types.BlockStatusBlock,
+				"update_time":  time.Now(),
+			})
+		} else {
+			err = model.NewBlockModel(tx).Insert(l.ctx, &model.Block{
+				UserID:        in.UserId,
+				BlockedUserID: in.BlockedUserId,
+				BlockStatus:   types.BlockStatusBlock,
+				CreateTime:    time.Now(),
+				UpdateTime:    time.Now(),
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		// 在事务中加锁读取双方的关注关系，避免读取后被并发的关注覆盖
+		following, err := model.NewFollowModel(tx).FindByUserIDAndFollowedUserIDForUpdate(l.ctx, in.UserId, in.BlockedUserId)
+		if err != nil {
+			return err
+		}
+		followed, err := model.NewFollowModel(tx).FindByUserIDAndFollowedUserIDForUpdate(l.ctx, in.BlockedUserId, in.UserId)
+		if err != nil {
+			return err
+		}
+		if err = unfollowTx(l.ctx, tx, following); err != nil {
+			return err
+		}
+		return unfollowTx(l.ctx, tx, followed)
+	})
+	if err != nil {
+		l.Logger.Errorf("[Block] Transaction error: %v", err)
+		return nil, err
+	}
+
+	// 3、删除双方的关注和粉丝缓存
+	if err = delFollowCache(l.ctx, l.svcCtx.BizRedis, in.UserId, in.BlockedUserId); err != nil {
+		return nil, err
+	}
+	if err = delFollowCache(l.ctx, l.svcCtx.BizRedis, in.BlockedUserId, in.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.BlockResponse{}, nil
+}
+
+// 在事务中取消关注并减少关注数和粉丝数，未关注时不做处理
+func unfollowTx(ctx context.Context, tx *gorm.DB, follow *model.Follow) error {
+	if follow == nil || follow.FollowStatus != types.FollowStatusFollow {
+		return nil
+	}
+
+	err := model.NewFollowModel(tx).UpdateFields(ctx, follow.ID, map[string]any{
+		"follow_status": types.FollowStatusUnfollow,
+		"update_time":   time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = model.NewFollowCountModel(tx).DecrFollowCount(ctx, follow.UserID)
+	if err != nil {
+		return err
+	}
+
+	return model.NewFollowCountModel(tx).DecrFansCount(ctx, follow.FollowedUserID)
+}
+
+// 从关注者的关注列表和被关注者的粉丝列表缓存中删除
+func delFollowCache(ctx context.Context, rds *redis.Redis, userId, followedUserId int64) error {
+	_, err := rds.ZremCtx(ctx, userFollowKey(userId), followedUserId)
+	if err != nil {
+		return err
+	}
+
+	_, err = rds.ZremCtx(ctx, userFansKey(followedUserId), userId)
+	return err
+}
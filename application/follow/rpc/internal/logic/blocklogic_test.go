@@ -0,0 +1,216 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"myBeyond/application/follow/code"
+	"myBeyond/application/follow/rpc/internal/types"
+	"myBeyond/application/follow/rpc/pb"
+)
+
+func seedFollow(t *testing.T, store *fakeStore, userId, followedUserId int64) {
+	t.Helper()
+	store.insert("follow", fakeRow{
+		"user_id":          userId,
+		"followed_user_id": followedUserId,
+		"follow_status":    int64(types.FollowStatusFollow),
+		"create_time":      time.Now(),
+		"update_time":      time.Now(),
+	})
+	c := store.counts[userId]
+	c[0]++
+	store.counts[userId] = c
+	c = store.counts[followedUserId]
+	c[1]++
+	store.counts[followedUserId] = c
+}
+
+func followStatus(store *fakeStore, userId, followedUserId int64) int64 {
+	rows := store.rows("follow", func(r fakeRow) bool {
+		return r["user_id"] == userId && r["followed_user_id"] == followedUserId
+	})
+	if len(rows) == 0 {
+		return 0
+	}
+	return rows[0]["follow_status"].(int64)
+}
+
+func blockStatus(store *fakeStore, userId, blockedUserId int64) int64 {
+	rows := store.rows("block", func(r fakeRow) bool {
+		return r["user_id"] == userId && r["blocked_user_id"] == blockedUserId
+	})
+	if len(rows) == 0 {
+		return 0
+	}
+	return rows[0]["block_status"].(int64)
+}
+
+func TestBlock(t *testing.T) {
+	svcCtx, store, rds := newTestServiceContext(t)
+	seedFollow(t, store, 1, 2)
+	seedFollow(t, store, 2, 1)
+	rds.zsets[userFollowKey(1)] = map[string]string{"2": "1", "3": "1"}
+	rds.zsets[userFansKey(1)] = map[string]string{"2": "1"}
+
+	_, err := NewBlockLogic(context.Background(), svcCtx).Block(&pb.BlockRequest{UserId: 1, BlockedUserId: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if status := blockStatus(store, 1, 2); status != types.BlockStatusBlock {
+		t.Errorf("block status = %d", status)
+	}
+	// 双向取消关注并减少计数
+	if status := followStatus(store, 1, 2); status != types.FollowStatusUnfollow {
+		t.Errorf("follow 1->2 status = %d", status)
+	}
+	if status := followStatus(store, 2, 1); status != types.FollowStatusUnfollow {
+		t.Errorf("follow 2->1 status = %d", status)
+	}
+	for _, userId := range []int64{1, 2} {
+		if c := store.counts[userId]; c != [2]int64{0, 0} {
+			t.Errorf("user %d counts = %v", userId, c)
+		}
+	}
+	// 关注关系在事务中加锁读取
+	reads := store.queriesLike("FROM `follow`")
+	if len(reads) != 2 {
+		t.Fatalf("follow reads = %d", len(reads))
+	}
+	for _, q := range reads {
+		if !q.inTx {
+			t.Errorf("follow read outside transaction: %s", q.sql)
+		}
+	}
+	if len(store.queriesLike("FOR UPDATE")) != 2 {
+		t.Error("follow reads should lock rows")
+	}
+	// 删除关注和粉丝缓存
+	if _, ok := rds.members(userFollowKey(1))["2"]; ok {
+		t.Error("follow cache not removed")
+	}
+	if _, ok := rds.members(userFollowKey(1))["3"]; !ok {
+		t.Error("unrelated follow cache removed")
+	}
+	if _, ok := rds.members(userFansKey(1))["2"]; ok {
+		t.Error("fans cache not removed")
+	}
+}
+
+func TestBlockAgain(t *testing.T) {
+	svcCtx, store, _ := newTestServiceContext(t)
+	l := NewBlockLogic(context.Background(), svcCtx)
+	if _, err := l.Block(&pb.BlockRequest{UserId: 1, BlockedUserId: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Block(&pb.BlockRequest{UserId: 1, BlockedUserId: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if rows := store.rows("block", func(fakeRow) bool { return true }); len(rows) != 1 {
+		t.Errorf("block rows = %d", len(rows))
+	}
+}
+
+func TestBlockSelf(t *testing.T) {
+	svcCtx, _, _ := newTestServiceContext(t)
+	_, err := NewBlockLogic(context.Background(), svcCtx).Block(&pb.BlockRequest{UserId: 1, BlockedUserId: 1})
+	if err != code.CannotBlockSelf {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestUnBlock(t *testing.T) {
+	svcCtx, store, _ := newTestServiceContext(t)
+	seedFollow(t, store, 1, 2)
+	if _, err := NewBlockLogic(context.Background(), svcCtx).Block(&pb.BlockRequest{UserId: 1, BlockedUserId: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := NewUnBlockLogic(context.Background(), svcCtx).UnBlock(&pb.UnBlockRequest{UserId: 1, BlockedUserId: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := blockStatus(store, 1, 2); status != types.BlockStatusUnblock {
+		t.Errorf("block status = %d", status)
+	}
+	// 取消拉黑不恢复关注
+	if status := followStatus(store, 1, 2); status != types.FollowStatusUnfollow {
+		t.Errorf("follow status = %d", status)
+	}
+
+	// 没有拉黑时取消拉黑不报错
+	_, err = NewUnBlockLogic(context.Background(), svcCtx).UnBlock(&pb.UnBlockRequest{UserId: 2, BlockedUserId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFollowBlocked(t *testing.T) {
+	svcCtx, store, _ := newTestServiceContext(t)
+	if _, err := NewBlockLogic(context.Background(), svcCtx).Block(&pb.BlockRequest{UserId: 2, BlockedUserId: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 任一方向的拉黑都不能关注
+	for _, req := range []*pb.FollowRequest{
+		{UserId: 1, FollowedUserId: 2},
+		{UserId: 2, FollowedUserId: 1},
+	} {
+		_, err := NewFollowLogic(context.Background(), svcCtx).Follow(req)
+		if err != code.FollowBlocked {
+			t.Errorf("follow %d->%d err = %v", req.UserId, req.FollowedUserId, err)
+		}
+		if status := followStatus(store, req.UserId, req.FollowedUserId); status != 0 {
+			t.Errorf("follow %d->%d status = %d", req.UserId, req.FollowedUserId, status)
+		}
+	}
+
+	// 取消拉黑后可以关注
+	if _, err := NewUnBlockLogic(context.Background(), svcCtx).UnBlock(&pb.UnBlockRequest{UserId: 2, BlockedUserId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFollowLogic(context.Background(), svcCtx).Follow(&pb.FollowRequest{UserId: 1, FollowedUserId: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if status := followStatus(store, 1, 2); status != types.FollowStatusFollow {
+		t.Errorf("follow status = %d", status)
+	}
+	if c := store.counts[2]; c[1] != 1 {
+		t.Errorf("fans count = %d", c[1])
+	}
+}
+
+// 事务外检查之后对方拉黑，事务中加锁再检查时拒绝关注
+func TestFollowBlockedAfterCheck(t *testing.T) {
+	svcCtx, store, _ := newTestServiceContext(t)
+	store.afterQuery = func(query string, inTx bool) {
+		if !inTx && strings.Contains(query, "FROM `block`") {
+			store.afterQuery = nil
+			store.insert("block", fakeRow{"user_id": int64(2), "blocked_user_id": int64(1), "block_status": int64(types.BlockStatusBlock)})
+		}
+	}
+
+	_, err := NewFollowLogic(context.Background(), svcCtx).Follow(&pb.FollowRequest{UserId: 1, FollowedUserId: 2})
+	if err != code.FollowBlocked {
+		t.Fatalf("err = %v", err)
+	}
+	if status := followStatus(store, 1, 2); status != 0 {
+		t.Errorf("follow status = %d", status)
+	}
+	if c := store.counts[1]; c[0] != 0 {
+		t.Errorf("follow count = %d", c[0])
+	}
+
+	var locked bool
+	for _, q := range store.queriesLike("FROM `block`") {
+		if q.inTx && strings.Contains(q.sql, "FOR UPDATE") {
+			locked = true
+		}
+	}
+	if !locked {
+		t.Error("block should be rechecked with a locking read in transaction")
+	}
+}
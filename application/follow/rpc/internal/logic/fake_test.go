@@ -0,0 +1,439 @@
+package logic
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"myBeyond/application/follow/rpc/internal/model"
+	"myBeyond/application/follow/rpc/internal/svc"
+	"myBeyond/pkg/orm"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// 测试环境没有mysql和redis，用内存实现gorm生成的几类sql和用到的redis命令
+
+type fakeRow map[string]driver.Value
+
+// fakeQuery 记录执行过的sql，inTx表示是否在事务中执行
+type fakeQuery struct {
+	sql  string
+	inTx bool
+}
+
+type fakeStore struct {
+	mu      sync.Mutex
+	tables  map[string][]fakeRow
+	counts  map[int64][2]int64 // user_id -> [follow_count, fans_count]
+	nextId  int64
+	queries []fakeQuery
+	// 事务开始时的快照，回滚时恢复
+	snapshot *fakeStore
+	// afterQuery 在查询之后调用，用于模拟并发的修改
+	afterQuery func(query string, inTx bool)
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		tables: make(map[string][]fakeRow),
+		counts: make(map[int64][2]int64),
+	}
+}
+
+func (s *fakeStore) clone() *fakeStore {
+	ret := newFakeStore()
+	ret.nextId = s.nextId
+	for name, rows := range s.tables {
+		for _, row := range rows {
+			r := make(fakeRow, len(row))
+			for k, v := range row {
+				r[k] = v
+			}
+			ret.tables[name] = append(ret.tables[name], r)
+		}
+	}
+	for k, v := range s.counts {
+		ret.counts[k] = v
+	}
+	return ret
+}
+
+func (s *fakeStore) insert(table string, row fakeRow) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextId++
+	row["id"] = s.nextId
+	s.tables[table] = append(s.tables[table], row)
+	return s.nextId
+}
+
+func (s *fakeStore) rows(table string, match func(fakeRow) bool) []fakeRow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ret []fakeRow
+	for _, row := range s.tables[table] {
+		if match(row) {
+			ret = append(ret, row)
+		}
+	}
+	return ret
+}
+
+func (s *fakeStore) queriesLike(substr string) []fakeQuery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ret []fakeQuery
+	for _, q := range s.queries {
+		if strings.Contains(q.sql, substr) {
+			ret = append(ret, q)
+		}
+	}
+	return ret
+}
+
+var (
+	tableRe   = regexp.MustCompile("(?:FROM|INTO|UPDATE) `?(\\w+)`?")
+	insertRe  = regexp.MustCompile(`\(([^)]*)\) VALUES`)
+	setRe     = regexp.MustCompile("`(\\w+)`=\\?")
+	equalWhRe = regexp.MustCompile(`(\w+) = \?`)
+)
+
+func (s *fakeStore) exec(query string, args []driver.Value) (int64, int64, error) {
+	table := tableRe.FindStringSubmatch(query)[1]
+	switch {
+	case table == "follow_count":
+		return 0, s.execCount(query, args), nil
+	case strings.HasPrefix(query, "INSERT"):
+		cols := strings.Split(insertRe.FindStringSubmatch(query)[1], ",")
+		row := make(fakeRow, len(cols))
+		for i, col := range cols {
+			row[strings.Trim(col, "`")] = args[i]
+		}
+		return s.insert(table, row), 1, nil
+	case strings.HasPrefix(query, "UPDATE"):
+		// UPDATE `t` SET `a`=?,`b`=? WHERE id = ?
+		sets := setRe.FindAllStringSubmatch(query, -1)
+		id := args[len(args)-1]
+		rows := s.rows(table, func(r fakeRow) bool { return r["id"] == id })
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, row := range rows {
+			for i, set := range sets {
+				row[set[1]] = args[i]
+			}
+		}
+		return 0, int64(len(rows)), nil
+	}
+	return 0, 0, fmt.Errorf("unsupported exec: %s", query)
+}
+
+func (s *fakeStore) execCount(query string, args []driver.Value) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	userId := args[0].(int64)
+	c := s.counts[userId]
+	idx := 0
+	if strings.Contains(query, "fans_count") {
+		idx = 1
+	}
+	if strings.HasPrefix(query, "INSERT") {
+		c[idx]++
+	} else if c[idx] > 0 {
+		c[idx]--
+	} else {
+		return 0
+	}
+	s.counts[userId] = c
+	return 1
+}
+
+func (s *fakeStore) query(query string, args []driver.Value) ([]fakeRow, error) {
+	table := tableRe.FindStringSubmatch(query)[1]
+	if strings.Contains(query, " OR ") {
+		// BlockModel.FindBetween，参数为 status, userId, targets..., userId, targets...
+		n := (len(args) - 3) / 2
+		status, userId, targets := args[0], args[1], args[2:2+n]
+		return s.rows(table, func(r fakeRow) bool {
+			if r["block_status"] != status {
+				return false
+			}
+			for _, t := range targets {
+				if (r["user_id"] == userId && r["blocked_user_id"] == t) || (r["blocked_user_id"] == userId && r["user_id"] == t) {
+					return true
+				}
+			}
+			return false
+		}), nil
+	}
+
+	where, _, _ := strings.Cut(query, " ORDER BY")
+	conds := equalWhRe.FindAllStringSubmatch(where, -1)
+	ret := s.rows(table, func(r fakeRow) bool {
+		for i, cond := range conds {
+			if r[cond[1]] != args[i] {
+				return false
+			}
+		}
+		return true
+	})
+	if strings.Contains(query, "LIMIT") && len(ret) > 1 {
+		ret = ret[:1]
+	}
+	return ret, nil
+}
+
+type fakeConn struct {
+	store *fakeStore
+	inTx  bool
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare not supported: %s", query)
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	c.store.mu.Lock()
+	c.store.snapshot = c.store.clone()
+	c.store.mu.Unlock()
+	c.inTx = true
+	return c, nil
+}
+
+func (c *fakeConn) Commit() error {
+	c.inTx = false
+	c.store.snapshot = nil
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	c.inTx = false
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	if snap := c.store.snapshot; snap != nil {
+		c.store.tables, c.store.counts, c.store.nextId = snap.tables, snap.counts, snap.nextId
+		c.store.snapshot = nil
+	}
+	return nil
+}
+
+func (c *fakeConn) record(query string) {
+	c.store.mu.Lock()
+	c.store.queries = append(c.store.queries, fakeQuery{sql: query, inTx: c.inTx})
+	c.store.mu.Unlock()
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	c.record(query)
+	id, affected, err := c.store.exec(query, values(named))
+	if err != nil {
+		return nil, err
+	}
+	return fakeResult{id: id, affected: affected}, nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, named []driver.NamedValue) (driver.Rows, error) {
+	c.record(query)
+	rows, err := c.store.query(query, values(named))
+	if err != nil {
+		return nil, err
+	}
+	if c.store.afterQuery != nil {
+		c.store.afterQuery(query, c.inTx)
+	}
+	return &fakeRows{rows: rows}, nil
+}
+
+func values(named []driver.NamedValue) []driver.Value {
+	ret := make([]driver.Value, len(named))
+	for i, v := range named {
+		ret[i] = v.Value
+	}
+	return ret
+}
+
+type fakeResult struct {
+	id, affected int64
+}
+
+func (r fakeResult) LastInsertId() (int64, error) { return r.id, nil }
+func (r fakeResult) RowsAffected() (int64, error) { return r.affected, nil }
+
+var fakeColumns = []string{"id", "user_id", "followed_user_id", "blocked_user_id", "follow_status", "block_status", "create_time", "update_time"}
+
+type fakeRows struct {
+	rows []fakeRow
+	pos  int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return []string{"id"}
+	}
+	var cols []string
+	for _, col := range fakeColumns {
+		if _, ok := r.rows[0][col]; ok {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	for i, col := range r.Columns() {
+		dest[i] = r.rows[r.pos][col]
+	}
+	r.pos++
+	return nil
+}
+
+type fakeConnector struct {
+	store *fakeStore
+}
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{store: c.store}, nil
+}
+
+func (c fakeConnector) Driver() driver.Driver { return nil }
+
+// fakeRedis 只实现用到的有序集合命令
+type fakeRedis struct {
+	mu    sync.Mutex
+	zsets map[string]map[string]string
+}
+
+func (r *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		io.WriteString(conn, r.handle(args))
+	}
+}
+
+func (r *fakeRedis) handle(args []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "EXISTS":
+		if _, ok := r.zsets[args[1]]; ok {
+			return ":1\r\n"
+		}
+		return ":0\r\n"
+	case "ZADD":
+		set, ok := r.zsets[args[1]]
+		if !ok {
+			set = make(map[string]string)
+			r.zsets[args[1]] = set
+		}
+		var added int
+		for i := 2; i+1 < len(args); i += 2 {
+			if _, ok := set[args[i+1]]; !ok {
+				added++
+			}
+			set[args[i+1]] = args[i]
+		}
+		return fmt.Sprintf(":%d\r\n", added)
+	case "ZREM":
+		var removed int
+		for _, member := range args[2:] {
+			if _, ok := r.zsets[args[1]][member]; ok {
+				delete(r.zsets[args[1]], member)
+				removed++
+			}
+		}
+		return fmt.Sprintf(":%d\r\n", removed)
+	case "ZREMRANGEBYRANK":
+		return ":0\r\n"
+	}
+	return "-ERR unknown command '" + args[0] + "'\r\n"
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err = reader.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(arg, "\r\n")
+	}
+	return args, nil
+}
+
+func (r *fakeRedis) members(key string) map[string]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.zsets[key]
+}
+
+func newTestServiceContext(t *testing.T) (*svc.ServiceContext, *fakeStore, *fakeRedis) {
+	store := newFakeStore()
+	sqlDB := sql.OpenDB(fakeConnector{store: store})
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{
+		Logger: logger.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rds := &fakeRedis{zsets: make(map[string]map[string]string)}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go rds.serve(conn)
+		}
+	}()
+
+	return &svc.ServiceContext{
+		DB:               &orm.DB{DB: db},
+		FollowModel:      model.NewFollowModel(db),
+		FollowCountModel: model.NewFollowCountModel(db),
+		BlockModel:       model.NewBlockModel(db),
+		BizRedis:         redis.New(ln.Addr().String()),
+	}, store, rds
+}
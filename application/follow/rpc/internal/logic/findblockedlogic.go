@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/follow/code"
+	"myBeyond/application/follow/rpc/internal/svc"
+	"myBeyond/application/follow/rpc/internal/types"
+	"myBeyond/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FindBlockedLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFindBlockedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FindBlockedLogic {
+	return &FindBlockedLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// FindBlocked 用于文章列表、详情等场景过滤与当前用户存在拉黑关系的作者
+func (l *FindBlockedLogic) FindBlocked(in *pb.FindBlockedRequest) (*pb.FindBlockedResponse, error) {
+	// 1、检验参数，去掉重复的用户和自己
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	targets := make([]int64, 0, len(in.TargetUserIds))
+	seen := make(map[int64]struct{}, len(in.TargetUserIds))
+	for _, id := range in.TargetUserIds {
+		if id == 0 || id == in.UserId {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		targets = append(targets, id)
+	}
+	if len(targets) == 0 {
+		return &pb.FindBlockedResponse{}, nil
+	}
+	if len(targets) > types.MaxFindBlocked {
+		return nil, code.FindBlockedTooMany
+	}
+
+	// 2、查询任一方向的拉黑关系
+	blocks, err := l.svcCtx.BlockModel.FindBetween(l.ctx, in.UserId, targets)
+	if err != nil {
+		l.Logger.Errorf("[FindBlocked] BlockModel.FindBetween err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	var blockedUserIds []int64
+	blocked := make(map[int64]struct{}, len(blocks))
+	for _, block := range blocks {
+		id := block.BlockedUserID
+		if id == in.UserId {
+			id = block.UserID
+		}
+		if _, ok := blocked[id]; ok {
+			continue
+		}
+		blocked[id] = struct{}{}
+		blockedUserIds = append(blockedUserIds, id)
+	}
+
+	return &pb.FindBlockedResponse{BlockedUserIds: blockedUserIds}, nil
+}
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -42,6 +43,16 @@ func (l *FollowLogic) Follow(in *pb.FollowRequest) (*pb.FollowResponse, error) {
 		return nil, code.CannotFollowSelf
 	}
 
+	// 1.1、任一方拉黑了对方都不能关注
+	blocks, err := l.svcCtx.BlockModel.FindBetween(l.ctx, in.UserId, []int64{in.FollowedUserId})
+	if err != nil {
+		l.Logger.Errorf("[Follow] BlockModel.FindBetween err: %v req: %v", err, in)
+		return nil, err
+	}
+	if len(blocks) > 0 {
+		return nil, code.FollowBlocked
+	}
+
 	// 2、增加或更新关注表和关注人数表，使用事务实现
 	follow, err := l.svcCtx.FollowModel.FindByUserIDAndFollowedUserID(l.ctx, in.UserId, in.FollowedUserId)
 	if err != nil {
@@ -54,6 +65,15 @@ func (l *FollowLogic) Follow(in *pb.FollowRequest) (*pb.FollowResponse, error) {
 
 	// 2.1、判断关注表中是否有关系，没有关系增加，有关系修改
 	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		// 事务中加锁再检查一次拉黑，上面检查之后对方可能刚拉黑
+		blocks, err := model.NewBlockModel(tx).FindBetweenForUpdate(l.ctx, in.UserId, []int64{in.FollowedUserId})
+		if err != nil {
+			return err
+		}
+		if len(blocks) > 0 {
+			return code.FollowBlocked
+		}
+
 		// 增加或更新关注表
 		if follow != nil {
 			err = model.NewFollowModel(tx).UpdateFields(l.ctx, follow.ID, map[string]any{
@@ -80,6 +100,9 @@ func (l *FollowLogic) Follow(in *pb.FollowRequest) (*pb.FollowResponse, error) {
 		}
 		return model.NewFollowCountModel(tx).IncrFansCount(l.ctx, in.FollowedUserId)
 	})
+	if errors.Is(err, code.FollowBlocked) {
+		return nil, err
+	}
 	if err != nil {
 		l.Logger.Errorf("[Follow] Transaction error: %v", err)
 		return nil, err
@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/follow/code"
+	"myBeyond/application/follow/rpc/internal/svc"
+	"myBeyond/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// 每批取消的关注关系数
+const removeRelationsBatch = 100
+
+type RemoveUserRelationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveUserRelationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveUserRelationsLogic {
+	return &RemoveUserRelationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RemoveUserRelations 重复调用是安全的，已经取消的关系不会再次扣减计数
+func (l *RemoveUserRelationsLogic) RemoveUserRelations(in *pb.RemoveUserRelationsRequest) (*pb.RemoveUserRelationsResponse, error) {
+	// 1、检验参数
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+
+	// 2、分批取消关注，同时扣减对方的关注数或粉丝数
+	var count int64
+	for {
+		follows, err := l.svcCtx.FollowModel.FindActiveByUser(l.ctx, in.UserId, removeRelationsBatch)
+		if err != nil {
+			l.Logger.Errorf("[RemoveUserRelations] FollowModel.FindActiveByUser err: %v req: %v", err, in)
+			return nil, err
+		}
+		if len(follows) == 0 {
+			break
+		}
+
+		err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+			for _, follow := range follows {
+				if err := unfollowTx(l.ctx, tx, follow); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			l.Logger.Errorf("[RemoveUserRelations] Transaction error: %v", err)
+			return nil, err
+		}
+		for _, follow := range follows {
+			if err = delFollowCache(l.ctx, l.svcCtx.BizRedis, follow.UserID, follow.FollowedUserID); err != nil {
+				l.Logger.Errorf("[RemoveUserRelations] delFollowCache follow: %d error: %v", follow.ID, err)
+				return nil, err
+			}
+		}
+		count += int64(len(follows))
+	}
+
+	// 3、删除用户的计数和关注、粉丝列表缓存
+	err := l.svcCtx.FollowCountModel.DeleteByUserId(l.ctx, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("[RemoveUserRelations] FollowCountModel.DeleteByUserId err: %v req: %v", err, in)
+		return nil, err
+	}
+	_, err = l.svcCtx.BizRedis.DelCtx(l.ctx, userFollowKey(in.UserId), userFansKey(in.UserId))
+	if err != nil {
+		l.Logger.Errorf("[RemoveUserRelations] Redis Del error: %v", err)
+		return nil, err
+	}
+
+	return &pb.RemoveUserRelationsResponse{Count: count}, nil
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"myBeyond/application/follow/code"
+	"myBeyond/application/follow/rpc/internal/svc"
+	"myBeyond/application/follow/rpc/internal/types"
+	"myBeyond/application/follow/rpc/pb"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnBlockLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnBlockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnBlockLogic {
+	return &UnBlockLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnBlock 取消拉黑，不恢复拉黑时取消的关注
+func (l *UnBlockLogic) UnBlock(in *pb.UnBlockRequest) (*pb.UnBlockResponse, error) {
+	// 1、校验参数
+	if in.UserId == 0 {
+		return nil, code.UserIdEmpty
+	}
+	if in.BlockedUserId == 0 {
+		return nil, code.BlockedUserIdEmpty
+	}
+
+	// 2、修改拉黑状态
+	block, err := l.svcCtx.BlockModel.FindByUserIDAndBlockedUserID(l.ctx, in.UserId, in.BlockedUserId)
+	if err != nil {
+		l.Logger.Errorf("[UnBlock] BlockModel.FindByUserIDAndBlockedUserID err: %v req: %v", err, in)
+		return nil, err
+	}
+	if block == nil || block.BlockStatus == types.BlockStatusUnblock {
+		return &pb.UnBlockResponse{}, nil
+	}
+
+	err = l.svcCtx.BlockModel.UpdateFields(l.ctx, block.ID, map[string]any{
+		"block_status": types.BlockStatusUnblock,
+		"update_time":  time.Now(),
+	})
+	if err != nil {
+		l.Logger.Errorf("[UnBlock] BlockModel.UpdateFields err: %v req: %v", err, in)
+		return nil, err
+	}
+
+	return &pb.UnBlockResponse{}, nil
+}
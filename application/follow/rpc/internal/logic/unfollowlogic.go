@@ -2,10 +2,8 @@ package logic
 
 import (
 	"context"
-	"time"
 
 	"myBeyond/application/follow/code"
-	"myBeyond/application/follow/rpc/internal/model"
 	"myBeyond/application/follow/rpc/internal/svc"
 	"myBeyond/application/follow/rpc/internal/types"
 	"myBeyond/application/follow/rpc/pb"
@@ -49,33 +47,14 @@ func (l *UnFollowLogic) UnFollow(in *pb.UnFollowRequest) (*pb.UnFollowResponse,
 	}
 
 	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
-		err = model.NewFollowModel(tx).UpdateFields(l.ctx, follow.ID, map[string]any{
-			"follow_status": types.FollowStatusUnfollow,
-			"update_time":   time.Now(),
-		})
-		if err != nil {
-			return err
-		}
-
-		err = model.NewFollowCountModel(tx).DecrFollowCount(l.ctx, in.UserId)
-		if err != nil {
-			return err
-		}
-
-		return model.NewFollowCountModel(tx).DecrFansCount(l.ctx, in.FollowedUserId)
-
+		return unfollowTx(l.ctx, tx, follow)
 	})
 	if err != nil {
 		l.Logger.Errorf("[UnFollow] Transaction error: %v", err)
 		return nil, err
 	}
 	// 3、删除缓存
-	_, err = l.svcCtx.BizRedis.ZremCtx(l.ctx, userFollowKey(in.UserId), in.FollowedUserId)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = l.svcCtx.BizRedis.ZremCtx(l.ctx, userFansKey(in.FollowedUserId), in.UserId)
+	err = delFollowCache(l.ctx, l.svcCtx.BizRedis, in.UserId, in.FollowedUserId)
 	if err != nil {
 		return nil, err
 	}
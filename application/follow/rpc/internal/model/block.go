@@ -0,0 +1,91 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"myBeyond/application/follow/rpc/internal/types"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Block struct {
+	ID            int64 `gorm:"primary_key"`
+	UserID        int64
+	BlockedUserID int64
+	BlockStatus   int
+	CreateTime    time.Time
+	UpdateTime    time.Time
+}
+
+func (m *Block) TableName() string {
+	return "block"
+}
+
+type BlockModel struct {
+	db *gorm.DB
+}
+
+func NewBlockModel(db *gorm.DB) *BlockModel {
+	return &BlockModel{
+		db: db,
+	}
+}
+
+func (m *BlockModel) Insert(ctx context.Context, data *Block) error {
+	return m.db.WithContext(ctx).Create(data).Error
+}
+
+func (m *BlockModel) UpdateFields(ctx context.Context, id int64, values map[string]interface{}) error {
+	return m.db.WithContext(ctx).Model(&Block{}).Where("id = ?", id).Updates(values).Error
+}
+
+func (m *BlockModel) FindByUserIDAndBlockedUserID(ctx context.Context, userId, blockedUserId int64) (*Block, error) {
+	var result Block
+	err := m.db.WithContext(ctx).
+		Where("user_id = ? AND blocked_user_id = ?", userId, blockedUserId).
+		First(&result).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &result, err
+}
+
+// FindByUserId 按id倒序分页查询用户拉黑的人，cursor为上一页最后一条的id
+func (m *BlockModel) FindByUserId(ctx context.Context, userId, cursor int64, limit int) ([]*Block, error) {
+	var result []*Block
+	err := m.db.WithContext(ctx).
+		Where("user_id = ? AND block_status = ? AND id < ?", userId, types.BlockStatusBlock, cursor).
+		Order("id desc").
+		Limit(limit).
+		Find(&result).Error
+
+	return result, err
+}
+
+// FindBetween 查询userId与targetUserIds之间任一方向的拉黑关系
+func (m *BlockModel) FindBetween(ctx context.Context, userId int64, targetUserIds []int64) ([]*Block, error) {
+	var result []*Block
+	err := m.db.WithContext(ctx).
+		Where("block_status = ?", types.BlockStatusBlock).
+		Where("(user_id = ? AND blocked_user_id IN ?) OR (blocked_user_id = ? AND user_id IN ?)",
+			userId, targetUserIds, userId, targetUserIds).
+		Find(&result).Error
+
+	return result, err
+}
+
+// FindBetweenForUpdate 在事务中加锁查询拉黑关系，与并发的拉黑互斥，避免检查之后对方拉黑仍然关注成功
+func (m *BlockModel) FindBetweenForUpdate(ctx context.Context, userId int64, targetUserIds []int64) ([]*Block, error) {
+	var result []*Block
+	err := m.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("block_status = ?", types.BlockStatusBlock).
+		Where("(user_id = ? AND blocked_user_id IN ?) OR (blocked_user_id = ? AND user_id IN ?)",
+			userId, targetUserIds, userId, targetUserIds).
+		Find(&result).Error
+
+	return result, err
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"time"
 
+	"myBeyond/application/follow/rpc/internal/types"
+
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Follow struct {
@@ -60,6 +63,20 @@ func (m *FollowModel) FindByUserIDAndFollowedUserID(ctx context.Context, userId,
 	return &result, err
 }
 
+// FindByUserIDAndFollowedUserIDForUpdate 在事务中加行锁读取关注关系，避免与并发的关注、取消关注重复修改计数
+func (m *FollowModel) FindByUserIDAndFollowedUserIDForUpdate(ctx context.Context, userId, followedUserId int64) (*Follow, error) {
+	var result Follow
+	err := m.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND followed_user_id = ?", userId, followedUserId).
+		First(&result).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	return &result, err
+}
+
 func (m *FollowModel) FindByUserId(ctx context.Context, userId int64, limit int, offset int) ([]*Follow, error) {
 	var result []*Follow
 	err := m.db.WithContext(ctx).
@@ -90,3 +107,14 @@ func (m *FollowModel) FindByFollowedUserId(ctx context.Context, userId int64, li
 		Find(&result).Error
 	return result, err
 }
+
+// FindActiveByUser 查询用户关注的人和用户的粉丝中仍处于关注状态的关系
+func (m *FollowModel) FindActiveByUser(ctx context.Context, userId int64, limit int) ([]*Follow, error) {
+	var result []*Follow
+	err := m.db.WithContext(ctx).
+		Where("(user_id = ? OR followed_user_id = ?) AND follow_status = ?", userId, userId, types.FollowStatusFollow).
+		Order("id").
+		Limit(limit).
+		Find(&result).Error
+	return result, err
+}
@@ -73,3 +73,7 @@ func (m *FollowCountModel) FindByUserIds(ctx context.Context, userIds []int64) (
 	err := m.db.WithContext(ctx).Where("user_id IN ?", userIds).Find(&result).Error
 	return result, err
 }
+
+func (m *FollowCountModel) DeleteByUserId(ctx context.Context, userId int64) error {
+	return m.db.WithContext(ctx).Where("user_id = ?", userId).Delete(&FollowCount{}).Error
+}
@@ -41,3 +41,28 @@ func (s *FollowServer) FansList(ctx context.Context, in *pb.FansListRequest) (*p
 	l := logic.NewFansListLogic(ctx, s.svcCtx)
 	return l.FansList(in)
 }
+
+func (s *FollowServer) Block(ctx context.Context, in *pb.BlockRequest) (*pb.BlockResponse, error) {
+	l := logic.NewBlockLogic(ctx, s.svcCtx)
+	return l.Block(in)
+}
+
+func (s *FollowServer) UnBlock(ctx context.Context, in *pb.UnBlockRequest) (*pb.UnBlockResponse, error) {
+	l := logic.NewUnBlockLogic(ctx, s.svcCtx)
+	return l.UnBlock(in)
+}
+
+func (s *FollowServer) BlockList(ctx context.Context, in *pb.BlockListRequest) (*pb.BlockListResponse, error) {
+	l := logic.NewBlockListLogic(ctx, s.svcCtx)
+	return l.BlockList(in)
+}
+
+func (s *FollowServer) FindBlocked(ctx context.Context, in *pb.FindBlockedRequest) (*pb.FindBlockedResponse, error) {
+	l := logic.NewFindBlockedLogic(ctx, s.svcCtx)
+	return l.FindBlocked(in)
+}
+
+func (s *FollowServer) RemoveUserRelations(ctx context.Context, in *pb.RemoveUserRelationsRequest) (*pb.RemoveUserRelationsResponse, error) {
+	l := logic.NewRemoveUserRelationsLogic(ctx, s.svcCtx)
+	return l.RemoveUserRelations(in)
+}
@@ -13,6 +13,7 @@ type ServiceContext struct {
 	DB               *orm.DB
 	FollowModel      *model.FollowModel
 	FollowCountModel *model.FollowCountModel
+	BlockModel       *model.BlockModel
 	BizRedis         *redis.Redis
 }
 
@@ -35,6 +36,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		DB:               db,
 		FollowModel:      model.NewFollowModel(db.DB),
 		FollowCountModel: model.NewFollowCountModel(db.DB),
+		BlockModel:       model.NewBlockModel(db.DB),
 		BizRedis:         rds,
 	}
 }
@@ -5,8 +5,14 @@ const (
 	FollowStatusUnfollow            // 取消关注
 )
 
+const (
+	BlockStatusBlock   = iota + 1 // 拉黑
+	BlockStatusUnblock            // 取消拉黑
+)
+
 const (
 	DefaultPageSize     = 20
 	CacheMaxFollowCount = 1000 // 缓存最大关注数
 	CacheMaxFansCount   = 1000 // 缓存最大粉丝数
+	MaxFindBlocked      = 100  // 批量查询拉黑关系的最大用户数
 )
@@ -26,6 +26,11 @@ type FollowClient interface {
 	UnFollow(ctx context.Context, in *UnFollowRequest, opts ...grpc.CallOption) (*UnFollowResponse, error)
 	FollowList(ctx context.Context, in *FollowListRequest, opts ...grpc.CallOption) (*FollowListResponse, error)
 	FansList(ctx context.Context, in *FansListRequest, opts ...grpc.CallOption) (*FansListResponse, error)
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	UnBlock(ctx context.Context, in *UnBlockRequest, opts ...grpc.CallOption) (*UnBlockResponse, error)
+	BlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListResponse, error)
+	FindBlocked(ctx context.Context, in *FindBlockedRequest, opts ...grpc.CallOption) (*FindBlockedResponse, error)
+	RemoveUserRelations(ctx context.Context, in *RemoveUserRelationsRequest, opts ...grpc.CallOption) (*RemoveUserRelationsResponse, error)
 }
 
 type followClient struct {
@@ -72,6 +77,51 @@ func (c *followClient) FansList(ctx context.Context, in *FansListRequest, opts .
 	return out, nil
 }
 
+func (c *followClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, "/follow.Follow/Block", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) UnBlock(ctx context.Context, in *UnBlockRequest, opts ...grpc.CallOption) (*UnBlockResponse, error) {
+	out := new(UnBlockResponse)
+	err := c.cc.Invoke(ctx, "/follow.Follow/UnBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) BlockList(ctx context.Context, in *BlockListRequest, opts ...grpc.CallOption) (*BlockListResponse, error) {
+	out := new(BlockListResponse)
+	err := c.cc.Invoke(ctx, "/follow.Follow/BlockList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) FindBlocked(ctx context.Context, in *FindBlockedRequest, opts ...grpc.CallOption) (*FindBlockedResponse, error) {
+	out := new(FindBlockedResponse)
+	err := c.cc.Invoke(ctx, "/follow.Follow/FindBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) RemoveUserRelations(ctx context.Context, in *RemoveUserRelationsRequest, opts ...grpc.CallOption) (*RemoveUserRelationsResponse, error) {
+	out := new(RemoveUserRelationsResponse)
+	err := c.cc.Invoke(ctx, "/follow.Follow/RemoveUserRelations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FollowServer is the server API for Follow service.
 // All implementations must embed UnimplementedFollowServer
 // for forward compatibility
@@ -80,6 +130,11 @@ type FollowServer interface {
 	UnFollow(context.Context, *UnFollowRequest) (*UnFollowResponse, error)
 	FollowList(context.Context, *FollowListRequest) (*FollowListResponse, error)
 	FansList(context.Context, *FansListRequest) (*FansListResponse, error)
+	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	UnBlock(context.Context, *UnBlockRequest) (*UnBlockResponse, error)
+	BlockList(context.Context, *BlockListRequest) (*BlockListResponse, error)
+	FindBlocked(context.Context, *FindBlockedRequest) (*FindBlockedResponse, error)
+	RemoveUserRelations(context.Context, *RemoveUserRelationsRequest) (*RemoveUserRelationsResponse, error)
 	mustEmbedUnimplementedFollowServer()
 }
 
@@ -99,6 +154,21 @@ func (UnimplementedFollowServer) FollowList(context.Context, *FollowListRequest)
 func (UnimplementedFollowServer) FansList(context.Context, *FansListRequest) (*FansListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FansList not implemented")
 }
+func (UnimplementedFollowServer) Block(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedFollowServer) UnBlock(context.Context, *UnBlockRequest) (*UnBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnBlock not implemented")
+}
+func (UnimplementedFollowServer) BlockList(context.Context, *BlockListRequest) (*BlockListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockList not implemented")
+}
+func (UnimplementedFollowServer) FindBlocked(context.Context, *FindBlockedRequest) (*FindBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindBlocked not implemented")
+}
+func (UnimplementedFollowServer) RemoveUserRelations(context.Context, *RemoveUserRelationsRequest) (*RemoveUserRelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserRelations not implemented")
+}
 func (UnimplementedFollowServer) mustEmbedUnimplementedFollowServer() {}
 
 // UnsafeFollowServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +254,96 @@ func _Follow_FansList_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Follow_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/follow.Follow/Block",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_UnBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).UnBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/follow.Follow/UnBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).UnBlock(ctx, req.(*UnBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_BlockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).BlockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/follow.Follow/BlockList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).BlockList(ctx, req.(*BlockListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_FindBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).FindBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/follow.Follow/FindBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).FindBlocked(ctx, req.(*FindBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_RemoveUserRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserRelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).RemoveUserRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/follow.Follow/RemoveUserRelations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).RemoveUserRelations(ctx, req.(*RemoveUserRelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Follow_ServiceDesc is the grpc.ServiceDesc for Follow service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +367,26 @@ var Follow_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FansList",
 			Handler:    _Follow_FansList_Handler,
 		},
+		{
+			MethodName: "Block",
+			Handler:    _Follow_Block_Handler,
+		},
+		{
+			MethodName: "UnBlock",
+			Handler:    _Follow_UnBlock_Handler,
+		},
+		{
+			MethodName: "BlockList",
+			Handler:    _Follow_BlockList_Handler,
+		},
+		{
+			MethodName: "FindBlocked",
+			Handler:    _Follow_FindBlocked_Handler,
+		},
+		{
+			MethodName: "RemoveUserRelations",
+			Handler:    _Follow_RemoveUserRelations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "follow.proto",
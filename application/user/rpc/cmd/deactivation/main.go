@@ -0,0 +1,153 @@
+// deactivation 清理冷静期已经结束的注销账号：软删除文章、取消关注关系、解绑第三方身份，最后匿名化用户信息
+// 每一步都可以重复执行，失败的账号会在下一轮重试
+//
+//	go run ./cmd/deactivation -f etc/user.yaml
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"myBeyond/application/article/rpc/article"
+	"myBeyond/application/follow/rpc/follow"
+	"myBeyond/application/user/rpc/internal/config"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/pkg/interceptors"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+var (
+	configFile = flag.String("f", "etc/user.yaml", "the config file")
+	batchSize  = flag.Int("batch", 100, "number of deactivations to process per round")
+	interval   = flag.Duration("interval", time.Minute, "interval between rounds")
+	once       = flag.Bool("once", false, "process due deactivations once and exit")
+)
+
+// Config 在user.rpc配置的基础上增加清理数据需要调用的服务
+type Config struct {
+	config.Config
+	ArticleRPC zrpc.RpcClientConf
+	FollowRPC  zrpc.RpcClientConf
+}
+
+type purger struct {
+	deactivationModel model.UserDeactivationModel
+	userModel         model.UserModel
+	identityModel     model.UserIdentityModel
+	articleRPC        article.Article
+	followRPC         follow.Follow
+}
+
+func main() {
+	flag.Parse()
+	if *batchSize <= 0 {
+		logx.Must(fmt.Errorf("invalid batch size: %d", *batchSize))
+	}
+
+	var c Config
+	conf.MustLoad(*configFile, &c)
+
+	conn := sqlx.NewMysql(c.DataSource)
+	clientOpt := zrpc.WithUnaryClientInterceptor(interceptors.ClientErrorInterceptor())
+	p := &purger{
+		deactivationModel: model.NewUserDeactivationModel(conn, c.CacheRedis),
+		userModel:         model.NewUserModel(conn, c.CacheRedis),
+		identityModel:     model.NewUserIdentityModel(conn, c.CacheRedis),
+		articleRPC:        article.NewArticle(zrpc.MustNewClient(c.ArticleRPC, clientOpt)),
+		followRPC:         follow.NewFollow(zrpc.MustNewClient(c.FollowRPC, clientOpt)),
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	for {
+		due, failed := p.runOnce(ctx)
+		if *once {
+			return
+		}
+		// 还有积压时立即处理下一批
+		if due == *batchSize && failed == 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(*interval):
+		}
+	}
+}
+
+func (p *purger) runOnce(ctx context.Context) (due, failed int) {
+	list, err := p.deactivationModel.FindDue(ctx, time.Now(), *batchSize)
+	if err != nil {
+		logx.Errorf("FindDue error: %v", err)
+		return 0, 0
+	}
+
+	for _, d := range list {
+		if err = p.purge(ctx, d); err != nil {
+			logx.Errorf("purge userId: %d error: %v", d.UserId, err)
+			failed++
+		}
+	}
+	if len(list) > 0 {
+		logx.Infof("purged deactivated users: %d, failed: %d", len(list)-failed, failed)
+	}
+
+	return len(list), failed
+}
+
+func (p *purger) purge(ctx context.Context, d *model.UserDeactivation) error {
+	// 1、进入清理状态，之后登录不能再取消注销
+	if d.Status == model.DeactivationStatusPending {
+		ok, err := p.deactivationModel.CompareAndSetStatus(ctx, d,
+			model.DeactivationStatusPending, model.DeactivationStatusDeleting)
+		if err != nil {
+			return err
+		}
+		// 用户在冷静期结束前登录取消了注销
+		if !ok {
+			return nil
+		}
+	}
+
+	// 2、软删除文章
+	if _, err := p.articleRPC.DeleteUserArticles(ctx, &article.DeleteUserArticlesRequest{UserId: d.UserId}); err != nil {
+		return fmt.Errorf("DeleteUserArticles: %w", err)
+	}
+
+	// 3、取消关注关系并删除计数
+	if _, err := p.followRPC.RemoveUserRelations(ctx, &follow.RemoveUserRelationsRequest{UserId: d.UserId}); err != nil {
+		return fmt.Errorf("RemoveUserRelations: %w", err)
+	}
+
+	// 4、解绑第三方身份
+	identities, err := p.identityModel.FindByUserId(ctx, d.UserId)
+	if err != nil {
+		return err
+	}
+	for _, identity := range identities {
+		if err = p.identityModel.Delete(ctx, identity.Id); err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+	}
+
+	// 5、匿名化用户信息
+	if err = p.userModel.Anonymize(ctx, d.UserId); err != nil && !errors.Is(err, model.ErrNotFound) {
+		return err
+	}
+
+	_, err = p.deactivationModel.CompareAndSetStatus(ctx, d,
+		model.DeactivationStatusDeleting, model.DeactivationStatusDeleted)
+	return err
+}
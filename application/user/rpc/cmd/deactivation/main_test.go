@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"myBeyond/application/article/rpc/article"
+	"myBeyond/application/follow/rpc/follow"
+	"myBeyond/application/user/rpc/internal/model"
+
+	"google.golang.org/grpc"
+)
+
+type fakeDeactivationModel struct {
+	model.UserDeactivationModel
+	status map[int64]int64 // id -> status
+}
+
+func (m *fakeDeactivationModel) CompareAndSetStatus(ctx context.Context, data *model.UserDeactivation, from, to int64) (bool, error) {
+	if m.status[data.Id] != from {
+		return false, nil
+	}
+	m.status[data.Id] = to
+	return true, nil
+}
+
+type fakeUserModel struct {
+	model.UserModel
+	anonymized []int64
+}
+
+func (m *fakeUserModel) Anonymize(ctx context.Context, id int64) error {
+	m.anonymized = append(m.anonymized, id)
+	return nil
+}
+
+type fakeIdentityModel struct {
+	model.UserIdentityModel
+	identities map[int64]*model.UserIdentity
+}
+
+func (m *fakeIdentityModel) FindByUserId(ctx context.Context, userId int64) ([]*model.UserIdentity, error) {
+	var ret []*model.UserIdentity
+	for _, identity := range m.identities {
+		if identity.UserId == userId {
+			ret = append(ret, identity)
+		}
+	}
+	return ret, nil
+}
+
+func (m *fakeIdentityModel) Delete(ctx context.Context, id int64) error {
+	delete(m.identities, id)
+	return nil
+}
+
+type fakeArticleRPC struct {
+	article.Article
+	err   error
+	calls []int64
+}
+
+func (f *fakeArticleRPC) DeleteUserArticles(ctx context.Context, in *article.DeleteUserArticlesRequest, opts ...grpc.CallOption) (*article.DeleteUserArticlesResponse, error) {
+	f.calls = append(f.calls, in.UserId)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &article.DeleteUserArticlesResponse{}, nil
+}
+
+// 接口中有Follow方法，直接嵌入follow.Follow时字段名与方法冲突，通过别名嵌入
+type followClient = follow.Follow
+
+type fakeFollowRPC struct {
+	followClient
+	calls []int64
+}
+
+func (f *fakeFollowRPC) RemoveUserRelations(ctx context.Context, in *follow.RemoveUserRelationsRequest, opts ...grpc.CallOption) (*follow.RemoveUserRelationsResponse, error) {
+	f.calls = append(f.calls, in.UserId)
+	return &follow.RemoveUserRelationsResponse{}, nil
+}
+
+func newTestPurger(d *model.UserDeactivation) *purger {
+	return &purger{
+		deactivationModel: &fakeDeactivationModel{status: map[int64]int64{d.Id: d.Status}},
+		userModel:         &fakeUserModel{},
+		identityModel: &fakeIdentityModel{identities: map[int64]*model.UserIdentity{
+			1: {Id: 1, UserId: d.UserId, Provider: "wechat"},
+			2: {Id: 2, UserId: d.UserId + 1, Provider: "wechat"},
+		}},
+		articleRPC: &fakeArticleRPC{},
+		followRPC:  &fakeFollowRPC{},
+	}
+}
+
+func statusOf(p *purger, d *model.UserDeactivation) int64 {
+	return p.deactivationModel.(*fakeDeactivationModel).status[d.Id]
+}
+
+func TestPurge(t *testing.T) {
+	d := &model.UserDeactivation{Id: 1, UserId: 10, Status: model.DeactivationStatusPending}
+	p := newTestPurger(d)
+
+	if err := p.purge(context.Background(), d); err != nil {
+		t.Fatal(err)
+	}
+
+	if status := statusOf(p, d); status != model.DeactivationStatusDeleted {
+		t.Errorf("status = %d", status)
+	}
+	if calls := p.articleRPC.(*fakeArticleRPC).calls; !reflect.DeepEqual(calls, []int64{10}) {
+		t.Errorf("DeleteUserArticles calls = %v", calls)
+	}
+	if calls := p.followRPC.(*fakeFollowRPC).calls; !reflect.DeepEqual(calls, []int64{10}) {
+		t.Errorf("RemoveUserRelations calls = %v", calls)
+	}
+	identities := p.identityModel.(*fakeIdentityModel).identities
+	if _, ok := identities[1]; ok || len(identities) != 1 {
+		t.Errorf("identities = %v", identities)
+	}
+	if ids := p.userModel.(*fakeUserModel).anonymized; !reflect.DeepEqual(ids, []int64{10}) {
+		t.Errorf("anonymized = %v", ids)
+	}
+}
+
+// 查询到期记录后用户登录取消了注销，清理任务不能再处理
+func TestPurgeCanceled(t *testing.T) {
+	d := &model.UserDeactivation{Id: 1, UserId: 10, Status: model.DeactivationStatusPending}
+	p := newTestPurger(d)
+	p.deactivationModel.(*fakeDeactivationModel).status[d.Id] = model.DeactivationStatusCanceled
+
+	if err := p.purge(context.Background(), d); err != nil {
+		t.Fatal(err)
+	}
+
+	if status := statusOf(p, d); status != model.DeactivationStatusCanceled {
+		t.Errorf("status = %d", status)
+	}
+	if calls := p.articleRPC.(*fakeArticleRPC).calls; len(calls) != 0 {
+		t.Errorf("DeleteUserArticles calls = %v", calls)
+	}
+	if ids := p.userModel.(*fakeUserModel).anonymized; len(ids) != 0 {
+		t.Errorf("anonymized = %v", ids)
+	}
+}
+
+// 清理失败时保持清理中状态，下一轮从清理中继续
+func TestPurgeRetry(t *testing.T) {
+	d := &model.UserDeactivation{Id: 1, UserId: 10, Status: model.DeactivationStatusPending}
+	p := newTestPurger(d)
+	articleRPC := p.articleRPC.(*fakeArticleRPC)
+	articleRPC.err = errors.New("article rpc unavailable")
+
+	if err := p.purge(context.Background(), d); !errors.Is(err, articleRPC.err) {
+		t.Fatalf("err = %v", err)
+	}
+	if status := statusOf(p, d); status != model.DeactivationStatusDeleting {
+		t.Errorf("status = %d", status)
+	}
+	if ids := p.userModel.(*fakeUserModel).anonymized; len(ids) != 0 {
+		t.Errorf("anonymized = %v", ids)
+	}
+
+	articleRPC.err = nil
+	d.Status = model.DeactivationStatusDeleting
+	if err := p.purge(context.Background(), d); err != nil {
+		t.Fatal(err)
+	}
+	if status := statusOf(p, d); status != model.DeactivationStatusDeleted {
+		t.Errorf("status = %d", status)
+	}
+	if ids := p.userModel.(*fakeUserModel).anonymized; !reflect.DeepEqual(ids, []int64{10}) {
+		t.Errorf("anonymized = %v", ids)
+	}
+}
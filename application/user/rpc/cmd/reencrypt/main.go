@@ -0,0 +1,93 @@
+// reencrypt 离线迁移user.mobile：把旧版本AES-ECB或者旧密钥的密文用当前密钥重新加密，并补全盲索引
+//
+//	go run ./cmd/reencrypt -f etc/user.yaml -dry-run
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"myBeyond/application/user/rpc/internal/config"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/pkg/encrypt"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var (
+	configFile = flag.String("f", "etc/user.yaml", "the config file")
+	batchSize  = flag.Int("batch", 200, "number of users to read per query")
+	dryRun     = flag.Bool("dry-run", false, "only count the users that need migration")
+)
+
+func main() {
+	flag.Parse()
+	if *batchSize <= 0 {
+		logx.Must(fmt.Errorf("invalid batch size: %d", *batchSize))
+	}
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+
+	userModel := model.NewUserModel(sqlx.NewMysql(c.DataSource), c.CacheRedis)
+	cipher := encrypt.MustNewCipher(c.MobileCipher)
+
+	var (
+		ctx                       = context.Background()
+		lastId                    int64
+		migrated, skipped, failed int
+	)
+	for {
+		users, err := userModel.FindAfterId(ctx, lastId, *batchSize)
+		if err != nil {
+			logx.Must(err)
+		}
+
+		for _, u := range users {
+			lastId = u.Id
+			// 只用邮箱注册和已注销的用户没有手机号
+			if !u.Mobile.Valid || len(u.Mobile.String) == 0 {
+				skipped++
+				continue
+			}
+
+			mobile, err := cipher.Decrypt(u.Mobile.String)
+			if err != nil {
+				logx.Errorf("Decrypt userId: %d error: %v", u.Id, err)
+				failed++
+				continue
+			}
+			mobileIndex := cipher.BlindIndex(mobile)
+			if !cipher.NeedReEncrypt(u.Mobile.String) && u.MobileIndex.Valid && u.MobileIndex.String == mobileIndex {
+				skipped++
+				continue
+			}
+			if *dryRun {
+				migrated++
+				continue
+			}
+
+			ciphertext, err := cipher.Encrypt(mobile)
+			if err != nil {
+				logx.Errorf("Encrypt userId: %d error: %v", u.Id, err)
+				failed++
+				continue
+			}
+			if err = userModel.UpdateMobile(ctx, u.Id, ciphertext, mobileIndex); err != nil {
+				logx.Errorf("UpdateMobile userId: %d error: %v", u.Id, err)
+				failed++
+				continue
+			}
+			migrated++
+		}
+
+		if len(users) < *batchSize {
+			break
+		}
+	}
+
+	fmt.Printf("migrated: %d, skipped: %d, failed: %d, dry run: %v\n", migrated, skipped, failed, *dryRun)
+}
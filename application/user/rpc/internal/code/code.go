@@ -5,5 +5,29 @@ import (
 )
 
 var (
-	RegisterNameEmpty = xcode.New(20001, "注册名字不能为空") // 注册名字为空
+	RegisterNameEmpty     = xcode.New(20001, "注册名字不能为空")     // 注册名字为空
+	LoginParamEmpty       = xcode.New(20002, "手机号或密码不能为空")   // 手机号或密码为空
+	MobileOrPasswordError = xcode.New(20003, "手机号或密码错误")     // 手机号或密码错误
+	SmsMobileEmpty        = xcode.New(20004, "短信手机号不能为空")    // 短信手机号为空
+	SmsTemplateEmpty      = xcode.New(20005, "短信模板不能为空")     // 短信模板为空
+	SmsSendFailed         = xcode.New(20006, "短信发送失败")       // 短信发送失败
+	UserNotExist          = xcode.New(20007, "用户不存在")        // 用户不存在
+	UsernameInvalid       = xcode.New(20008, "用户名不合法")       // 用户名长度或字符不合法
+	AvatarInvalid         = xcode.New(20009, "头像地址不合法")      // 头像地址不合法
+	FindByIdsTooMany      = xcode.New(20010, "批量查询的用户过多")    // 超过单次批量查询上限
+	MobileEmpty           = xcode.New(20011, "手机号不能为空")      // 手机号为空
+	MobileHasRegistered   = xcode.New(20012, "手机号已经注册")      // 新手机号已被其他用户使用
+	MobileNotChanged      = xcode.New(20013, "新手机号与原手机号相同")  // 新旧手机号相同
+	IdentityParamEmpty    = xcode.New(20014, "第三方身份参数不能为空")  // provider或openId为空
+	IdentityAlreadyBound  = xcode.New(20015, "第三方身份已绑定其他用户") // openId已绑定其他用户
+	AccountDeleting       = xcode.New(20016, "账号正在注销")       // 冷静期已结束，不能再取消
+	PasswordError         = xcode.New(20017, "密码错误")         // 注销账号时密码校验失败
+	EmailEmpty            = xcode.New(20018, "邮箱不能为空")       // 邮箱为空
+	EmailInvalid          = xcode.New(20019, "邮箱格式不正确")      // 邮箱格式不合法或过长
+	EmailHasRegistered    = xcode.New(20020, "邮箱已经被使用")      // 邮箱已绑定其他用户
+	EmailNotChanged       = xcode.New(20021, "新邮箱与原邮箱相同")    // 重复绑定同一个邮箱
+	EmailTemplateInvalid  = xcode.New(20022, "邮件模板不存在")      // 邮件模板不存在
+	EmailSendFailed       = xcode.New(20023, "邮件发送失败")       // 邮件发送失败
+	UserNoContact         = xcode.New(20024, "用户没有可用的联系方式")  // 没有绑定邮箱和手机号，或账号已注销
+	PasswordLoginLocked   = xcode.New(20025, "密码错误次数过多")     // 密码登录连续错误超过限制，锁定期间不再校验
 )
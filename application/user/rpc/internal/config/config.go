@@ -1,6 +1,11 @@
 package config
 
 import (
+	"myBeyond/application/user/rpc/internal/mail"
+	"myBeyond/application/user/rpc/internal/sms"
+	"myBeyond/pkg/encrypt"
+	"myBeyond/pkg/sensitive"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/zrpc"
@@ -11,4 +16,20 @@ type Config struct {
 	DataSource string
 	CacheRedis cache.CacheConf
 	BizRedis   redis.RedisConf
+	Sms        sms.Config
+	Mail       mail.Config `json:",optional"`
+	// 手机号加密的密钥，轮换时新增密钥并修改CurrentKeyId，再执行cmd/reencrypt
+	MobileCipher encrypt.CipherConf
+	// 账号注销的冷静期，秒
+	Deactivation struct {
+		CoolOff int64 `json:",default=1296000"`
+	} `json:",optional"`
+	// 密码登录的错误次数限制，按手机号的盲索引计数，Window秒内最多尝试Quota次，超过后锁定Lock秒
+	PasswordFailLimit struct {
+		Window int `json:",default=900"`
+		Quota  int `json:",default=5"`
+		Lock   int `json:",default=900"`
+	} `json:",optional"`
+	// 用户名中的敏感词替换为*，未配置词库时不处理
+	Sensitive sensitive.Conf `json:",optional"`
 }
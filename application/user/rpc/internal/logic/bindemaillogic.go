@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BindEmailLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBindEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BindEmailLogic {
+	return &BindEmailLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *BindEmailLogic) BindEmail(in *service.BindEmailRequest) (*service.BindEmailResponse, error) {
+	// 1、检查参数
+	email, err := normalizeEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2、邮箱不能被其他用户使用
+	u, err := findUserByEmail(l.ctx, l.svcCtx, email)
+	if err != nil {
+		l.Logger.Errorf("FindByEmail email: %s error: %v", email, err)
+		return nil, err
+	}
+	if u != nil {
+		if u.Id == in.UserId {
+			return nil, code.EmailNotChanged
+		}
+		return nil, code.EmailHasRegistered
+	}
+
+	// 3、修改邮箱，并发绑定时由唯一索引uk_email兜底
+	err = l.svcCtx.UserModel.UpdateEmail(l.ctx, in.UserId, email)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.UserNotExist
+		}
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry {
+			return nil, code.EmailHasRegistered
+		}
+		l.Logger.Errorf("UpdateEmail userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+
+	return &service.BindEmailResponse{}, nil
+}
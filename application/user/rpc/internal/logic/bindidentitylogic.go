@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BindIdentityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBindIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BindIdentityLogic {
+	return &BindIdentityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *BindIdentityLogic) BindIdentity(in *service.BindIdentityRequest) (*service.BindIdentityResponse, error) {
+	// 1、检查参数
+	in.Provider = strings.TrimSpace(in.Provider)
+	in.OpenId = strings.TrimSpace(in.OpenId)
+	in.UnionId = strings.TrimSpace(in.UnionId)
+	if len(in.Provider) == 0 || len(in.OpenId) == 0 {
+		return nil, code.IdentityParamEmpty
+	}
+
+	// 2、用户必须存在
+	_, err := l.svcCtx.UserModel.FindOne(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.UserNotExist
+		}
+		l.Logger.Errorf("FindOne userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+
+	// 3、绑定
+	err = bindIdentity(l.ctx, l.svcCtx, in.UserId, in.Provider, in.OpenId, in.UnionId)
+	if err != nil {
+		if !errors.Is(err, code.IdentityAlreadyBound) {
+			l.Logger.Errorf("bindIdentity userId: %d openId: %s error: %v", in.UserId, in.OpenId, err)
+		}
+		return nil, err
+	}
+
+	return &service.BindIdentityResponse{}, nil
+}
+
+// 绑定第三方身份，重复绑定到同一个用户视为成功，并发绑定时由唯一索引uk_provider_open_id兜底
+func bindIdentity(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, provider, openId, unionId string) error {
+	_, err := svcCtx.IdentityModel.Insert(ctx, &model.UserIdentity{
+		UserId:   userId,
+		Provider: provider,
+		OpenId:   openId,
+		UnionId:  unionId,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlErrDuplicateEntry {
+		return err
+	}
+	identity, err := svcCtx.IdentityModel.FindOneByProviderOpenId(ctx, provider, openId)
+	if err != nil {
+		return err
+	}
+	if identity.UserId != userId {
+		return code.IdentityAlreadyBound
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelDeactivationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelDeactivationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelDeactivationLogic {
+	return &CancelDeactivationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CancelDeactivationLogic) CancelDeactivation(in *service.CancelDeactivationRequest) (*service.CancelDeactivationResponse, error) {
+	deactivation, err := l.svcCtx.DeactivationModel.FindOneByUserId(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return &service.CancelDeactivationResponse{}, nil
+		}
+		l.Logger.Errorf("FindOneByUserId userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+
+	switch deactivation.Status {
+	case model.DeactivationStatusCanceled:
+		return &service.CancelDeactivationResponse{}, nil
+	case model.DeactivationStatusPending:
+	default:
+		return nil, code.AccountDeleting
+	}
+
+	// 清理任务可能同时开始处理，只有仍处于冷静期时才能取消
+	ok, err := l.svcCtx.DeactivationModel.CompareAndSetStatus(l.ctx, deactivation,
+		model.DeactivationStatusPending, model.DeactivationStatusCanceled)
+	if err != nil {
+		l.Logger.Errorf("CompareAndSetStatus userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+	if !ok {
+		return nil, code.AccountDeleting
+	}
+
+	return &service.CancelDeactivationResponse{Canceled: true}, nil
+}
@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"context"
+	"testing"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+)
+
+type fakeDeactivationModel struct {
+	model.UserDeactivationModel
+	data *model.UserDeactivation
+	// 模拟读取后清理任务抢先修改了状态
+	raceStatus int64
+}
+
+func (m *fakeDeactivationModel) FindOneByUserId(ctx context.Context, userId int64) (*model.UserDeactivation, error) {
+	if m.data == nil || m.data.UserId != userId {
+		return nil, model.ErrNotFound
+	}
+	ret := *m.data
+	if m.raceStatus > 0 {
+		m.data.Status = m.raceStatus
+	}
+	return &ret, nil
+}
+
+func (m *fakeDeactivationModel) CompareAndSetStatus(ctx context.Context, data *model.UserDeactivation, from, to int64) (bool, error) {
+	if m.data.Status != from {
+		return false, nil
+	}
+	m.data.Status = to
+	return true, nil
+}
+
+func TestCancelDeactivation(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       *model.UserDeactivation
+		raceStatus int64
+		canceled   bool
+		err        error
+		status     int64
+	}{
+		{name: "not deactivated"},
+		{
+			name:     "pending",
+			data:     &model.UserDeactivation{Id: 1, UserId: 10, Status: model.DeactivationStatusPending},
+			canceled: true,
+			status:   model.DeactivationStatusCanceled,
+		},
+		{
+			name:   "already canceled",
+			data:   &model.UserDeactivation{Id: 1, UserId: 10, Status: model.DeactivationStatusCanceled},
+			status: model.DeactivationStatusCanceled,
+		},
+		{
+			name:   "deleting",
+			data:   &model.UserDeactivation{Id: 1, UserId: 10, Status: model.DeactivationStatusDeleting},
+			err:    code.AccountDeleting,
+			status: model.DeactivationStatusDeleting,
+		},
+		{
+			name:       "purger wins",
+			data:       &model.UserDeactivation{Id: 1, UserId: 10, Status: model.DeactivationStatusPending},
+			raceStatus: model.DeactivationStatusDeleting,
+			err:        code.AccountDeleting,
+			status:     model.DeactivationStatusDeleting,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &fakeDeactivationModel{data: tt.data, raceStatus: tt.raceStatus}
+			l := NewCancelDeactivationLogic(context.Background(), &svc.ServiceContext{DeactivationModel: m})
+
+			ret, err := l.CancelDeactivation(&service.CancelDeactivationRequest{UserId: 10})
+			if err != tt.err {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err == nil && ret.Canceled != tt.canceled {
+				t.Errorf("canceled = %v", ret.Canceled)
+			}
+			if tt.data != nil && m.data.Status != tt.status {
+				t.Errorf("status = %d, want %d", m.data.Status, tt.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// mysql唯一索引冲突的错误码
+const mysqlErrDuplicateEntry = 1062
+
+type ChangeMobileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewChangeMobileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChangeMobileLogic {
+	return &ChangeMobileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ChangeMobileLogic) ChangeMobile(in *service.ChangeMobileRequest) (*service.ChangeMobileResponse, error) {
+	// 1、检查参数
+	in.Mobile = strings.TrimSpace(in.Mobile)
+	if len(in.Mobile) == 0 {
+		return nil, code.MobileEmpty
+	}
+
+	// 2、新手机号不能被其他用户使用
+	u, err := findUserByMobile(l.ctx, l.svcCtx, in.Mobile)
+	if err != nil {
+		l.Logger.Errorf("FindByMobile mobile: %s error: %v", in.Mobile, err)
+		return nil, err
+	}
+	if u != nil {
+		if u.Id == in.UserId {
+			return nil, code.MobileNotChanged
+		}
+		return nil, code.MobileHasRegistered
+	}
+
+	// 3、修改手机号，并发修改时由唯一索引uk_mobile_index兜底
+	mobile, err := l.svcCtx.MobileCipher.Encrypt(in.Mobile)
+	if err != nil {
+		return nil, err
+	}
+	mobileIndex := l.svcCtx.MobileCipher.BlindIndex(in.Mobile)
+	err = l.svcCtx.UserModel.UpdateMobile(l.ctx, in.UserId, mobile, mobileIndex)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.UserNotExist
+		}
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry {
+			return nil, code.MobileHasRegistered
+		}
+		l.Logger.Errorf("UpdateMobile userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+
+	return &service.ChangeMobileResponse{}, nil
+}
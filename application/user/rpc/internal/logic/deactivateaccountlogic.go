@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+	"myBeyond/pkg/encrypt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeactivateAccountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeactivateAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeactivateAccountLogic {
+	return &DeactivateAccountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DeactivateAccountLogic) DeactivateAccount(in *service.DeactivateAccountRequest) (*service.DeactivateAccountResponse, error) {
+	// 1、用户必须存在
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.UserNotExist
+		}
+		l.Logger.Errorf("FindOne userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+	// 1.1、使用密码确认时校验密码，未设置密码的用户只能使用验证码
+	if len(in.Password) > 0 {
+		if len(user.Password) == 0 {
+			return nil, code.PasswordError
+		}
+		if match, _ := encrypt.VerifyPassword(in.Password, user.Password); !match {
+			return nil, code.PasswordError
+		}
+	}
+
+	// 2、已经在冷静期内的重复申请直接返回
+	deleteTime := time.Now().Add(time.Duration(l.svcCtx.Config.Deactivation.CoolOff) * time.Second)
+	deactivation, err := l.svcCtx.DeactivationModel.FindOneByUserId(l.ctx, in.UserId)
+	switch {
+	case err == nil:
+		switch deactivation.Status {
+		case model.DeactivationStatusPending:
+			return &service.DeactivateAccountResponse{DeleteTime: deactivation.DeleteTime.Unix()}, nil
+		case model.DeactivationStatusCanceled:
+			// 3、取消过的申请重新进入冷静期
+			deactivation.Status = model.DeactivationStatusPending
+			deactivation.DeleteTime = deleteTime
+			err = l.svcCtx.DeactivationModel.Update(l.ctx, deactivation)
+		default:
+			return nil, code.AccountDeleting
+		}
+	case errors.Is(err, model.ErrNotFound):
+		_, err = l.svcCtx.DeactivationModel.Insert(l.ctx, &model.UserDeactivation{
+			UserId:     in.UserId,
+			Status:     model.DeactivationStatusPending,
+			DeleteTime: deleteTime,
+		})
+	}
+	if err != nil {
+		l.Logger.Errorf("DeactivateAccount userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+
+	return &service.DeactivateAccountResponse{DeleteTime: deleteTime.Unix()}, nil
+}
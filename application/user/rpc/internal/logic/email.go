@@ -0,0 +1,43 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/mail"
+	"strings"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+)
+
+// 与email字段的长度一致
+const maxEmailLen = 128
+
+// normalizeEmail 邮箱统一转为小写，只接受不带显示名的地址
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if len(email) == 0 {
+		return "", code.EmailEmpty
+	}
+	if len(email) > maxEmailLen {
+		return "", code.EmailInvalid
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", code.EmailInvalid
+	}
+
+	return email, nil
+}
+
+// findUserByEmail 用户不存在时返回nil，email需要已经经过normalizeEmail处理
+func findUserByEmail(ctx context.Context, svcCtx *svc.ServiceContext, email string) (*model.User, error) {
+	user, err := svcCtx.UserModel.FindOneByEmail(ctx, sql.NullString{String: email, Valid: true})
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, nil
+	}
+
+	return user, err
+}
@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FindByEmailLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFindByEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FindByEmailLogic {
+	return &FindByEmailLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *FindByEmailLogic) FindByEmail(in *service.FindByEmailRequest) (*service.FindByEmailResponse, error) {
+	email, err := normalizeEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := findUserByEmail(l.ctx, l.svcCtx, email)
+	if err != nil {
+		logx.Errorf("FindByEmail email: %s error: %v", email, err)
+		return nil, err
+	}
+	if user == nil {
+		return &service.FindByEmailResponse{}, nil
+	}
+
+	return &service.FindByEmailResponse{
+		UserId:   user.Id,
+		Username: user.Username,
+		Avatar:   user.Avatar,
+	}, nil
+}
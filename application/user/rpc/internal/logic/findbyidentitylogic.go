@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FindByIdentityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFindByIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FindByIdentityLogic {
+	return &FindByIdentityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *FindByIdentityLogic) FindByIdentity(in *service.FindByIdentityRequest) (*service.FindByIdentityResponse, error) {
+	// 1、检查参数
+	in.Provider = strings.TrimSpace(in.Provider)
+	in.OpenId = strings.TrimSpace(in.OpenId)
+	in.UnionId = strings.TrimSpace(in.UnionId)
+	if len(in.Provider) == 0 || len(in.OpenId) == 0 {
+		return nil, code.IdentityParamEmpty
+	}
+
+	// 2、openId已经绑定
+	identity, err := l.svcCtx.IdentityModel.FindOneByProviderOpenId(l.ctx, in.Provider, in.OpenId)
+	if err == nil {
+		return &service.FindByIdentityResponse{UserId: identity.UserId, Bound: true}, nil
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		l.Logger.Errorf("FindOneByProviderOpenId provider: %s openId: %s error: %v", in.Provider, in.OpenId, err)
+		return nil, err
+	}
+	if len(in.UnionId) == 0 {
+		return &service.FindByIdentityResponse{}, nil
+	}
+
+	// 3、同一开放平台下的其他应用已经绑定过，由调用方决定是否把当前openId绑定到同一个用户
+	identity, err = l.svcCtx.IdentityModel.FindOneByProviderUnionId(l.ctx, in.Provider, in.UnionId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return &service.FindByIdentityResponse{}, nil
+		}
+		l.Logger.Errorf("FindOneByProviderUnionId provider: %s unionId: %s error: %v", in.Provider, in.UnionId, err)
+		return nil, err
+	}
+
+	return &service.FindByIdentityResponse{UserId: identity.UserId}, nil
+}
@@ -0,0 +1,72 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+)
+
+type fakeIdentityModel struct {
+	model.UserIdentityModel
+	identities []*model.UserIdentity
+}
+
+func (m *fakeIdentityModel) FindOneByProviderOpenId(ctx context.Context, provider, openId string) (*model.UserIdentity, error) {
+	for _, identity := range m.identities {
+		if identity.Provider == provider && identity.OpenId == openId {
+			return identity, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m *fakeIdentityModel) FindOneByProviderUnionId(ctx context.Context, provider, unionId string) (*model.UserIdentity, error) {
+	for _, identity := range m.identities {
+		if identity.Provider == provider && identity.UnionId == unionId {
+			return identity, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (m *fakeIdentityModel) Insert(ctx context.Context, data *model.UserIdentity) (sql.Result, error) {
+	m.identities = append(m.identities, data)
+	return nil, nil
+}
+
+// 查找不绑定，按unionId找到的用户由调用方调用BindIdentity绑定
+func TestFindByIdentity(t *testing.T) {
+	m := &fakeIdentityModel{identities: []*model.UserIdentity{
+		{UserId: 10, Provider: "wechat", OpenId: "open-a", UnionId: "union-1"},
+	}}
+	l := NewFindByIdentityLogic(context.Background(), &svc.ServiceContext{IdentityModel: m})
+
+	tests := []struct {
+		name   string
+		req    *service.FindByIdentityRequest
+		userId int64
+		bound  bool
+	}{
+		{name: "bound", req: &service.FindByIdentityRequest{Provider: "wechat", OpenId: "open-a"}, userId: 10, bound: true},
+		{name: "same union", req: &service.FindByIdentityRequest{Provider: "wechat", OpenId: "open-b", UnionId: "union-1"}, userId: 10},
+		{name: "not bound", req: &service.FindByIdentityRequest{Provider: "wechat", OpenId: "open-c", UnionId: "union-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ret, err := l.FindByIdentity(tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ret.UserId != tt.userId || ret.Bound != tt.bound {
+				t.Errorf("ret = %+v, want userId %d bound %v", ret, tt.userId, tt.bound)
+			}
+		})
+	}
+	if len(m.identities) != 1 {
+		t.Errorf("identities inserted by lookup: %+v", m.identities[1:])
+	}
+}
@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 单次批量查询的用户上限
+const maxFindByIds = 100
+
+type FindByIdsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFindByIdsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FindByIdsLogic {
+	return &FindByIdsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *FindByIdsLogic) FindByIds(in *service.FindByIdsRequest) (*service.FindByIdsResponse, error) {
+	// 1、去重，过滤非法id
+	ids := make([]int64, 0, len(in.UserIds))
+	seen := make(map[int64]struct{}, len(in.UserIds))
+	for _, id := range in.UserIds {
+		if id <= 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	if len(ids) > maxFindByIds {
+		return nil, code.FindByIdsTooMany
+	}
+	if len(ids) == 0 {
+		return &service.FindByIdsResponse{}, nil
+	}
+
+	// 2、批量查询
+	users, err := l.svcCtx.UserModel.FindByIds(l.ctx, ids)
+	if err != nil {
+		l.Logger.Errorf("FindByIds userIds: %v error: %v", ids, err)
+		return nil, err
+	}
+
+	resp := &service.FindByIdsResponse{
+		Users: make(map[int64]*service.UserItem, len(users)),
+	}
+	for id, user := range users {
+		resp.Users[id] = &service.UserItem{
+			UserId:   user.Id,
+			Username: user.Username,
+			Avatar:   user.Avatar,
+		}
+	}
+
+	return resp, nil
+}
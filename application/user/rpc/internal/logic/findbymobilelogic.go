@@ -5,7 +5,6 @@ import (
 
 	"myBeyond/application/user/rpc/internal/svc"
 	"myBeyond/application/user/rpc/service"
-	"myBeyond/pkg/encrypt"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -25,13 +24,7 @@ func NewFindByMobileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Find
 }
 
 func (l *FindByMobileLogic) FindByMobile(in *service.FindByMobileRequest) (*service.FindByMobileResponse, error) {
-	mobile, err := encrypt.EncMobile(in.Mobile)
-	if err != nil {
-		return nil, err
-
-	}
-	user, err := l.svcCtx.UserModel.FindByMobile(l.ctx, mobile)
-
+	user, err := findUserByMobile(l.ctx, l.svcCtx, in.Mobile)
 	if err != nil {
 		logx.Errorf("FindByMobile mobile: %s error: %v", in.Mobile, err)
 		return nil, err
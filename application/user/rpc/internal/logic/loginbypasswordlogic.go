@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+	"myBeyond/pkg/encrypt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LoginByPasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLoginByPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginByPasswordLogic {
+	return &LoginByPasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *LoginByPasswordLogic) LoginByPassword(in *service.LoginByPasswordRequest) (*service.LoginByPasswordResponse, error) {
+	// 1、检查参数
+	if len(in.Mobile) == 0 || len(in.Password) == 0 {
+		return nil, code.LoginParamEmpty
+	}
+
+	// 2、按手机号的盲索引计数，校验之前占用一次尝试的机会，不存在的手机号同样计数
+	failKey := l.svcCtx.MobileCipher.BlindIndex(in.Mobile)
+	ok, err := l.svcCtx.PasswordFailLimit.TakeCtx(l.ctx, failKey)
+	if err != nil {
+		logx.Errorf("PasswordFailLimit TakeCtx error: %v", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, code.PasswordLoginLocked
+	}
+
+	// 3、根据手机号查找用户
+	user, err := findUserByMobile(l.ctx, l.svcCtx, in.Mobile)
+	if err != nil {
+		logx.Errorf("FindByMobile mobile: %s error: %v", in.Mobile, err)
+		return nil, err
+	}
+	// 用户不存在或者未设置密码，统一返回手机号或密码错误
+	if user == nil || len(user.Password) == 0 {
+		return nil, code.MobileOrPasswordError
+	}
+
+	// 4、校验密码，成功后清零尝试次数
+	match, needRehash := encrypt.VerifyPassword(in.Password, user.Password)
+	if !match {
+		return nil, code.MobileOrPasswordError
+	}
+	if err = l.svcCtx.PasswordFailLimit.ResetCtx(l.ctx, failKey); err != nil {
+		logx.Errorf("PasswordFailLimit ResetCtx userId: %d error: %v", user.Id, err)
+	}
+
+	// 5、旧格式的哈希在登录成功后升级，失败不影响本次登录
+	if needRehash {
+		l.upgradePassword(user.Id, in.Password)
+	}
+
+	return &service.LoginByPasswordResponse{UserId: user.Id}, nil
+}
+
+func (l *LoginByPasswordLogic) upgradePassword(userId int64, password string) {
+	hashed, err := encrypt.HashPassword(password)
+	if err != nil {
+		l.Logger.Errorf("HashPassword userId: %d error: %v", userId, err)
+		return
+	}
+	if err = l.svcCtx.UserModel.UpdatePassword(l.ctx, userId, hashed); err != nil {
+		l.Logger.Errorf("UpdatePassword userId: %d error: %v", userId, err)
+	}
+}
@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+	"myBeyond/pkg/encrypt"
+	"myBeyond/pkg/limit"
+)
+
+type fakePasswordUserModel struct {
+	model.UserModel
+	user *model.User
+}
+
+func (m *fakePasswordUserModel) FindOneByMobileIndex(ctx context.Context, mobileIndex sql.NullString) (*model.User, error) {
+	if m.user.MobileIndex != mobileIndex {
+		return nil, model.ErrNotFound
+	}
+	return m.user, nil
+}
+
+func (m *fakePasswordUserModel) FindByMobile(ctx context.Context, mobile string) (*model.User, error) {
+	return nil, nil
+}
+
+func newPasswordLoginTest(t *testing.T) *LoginByPasswordLogic {
+	cipher := encrypt.MustNewCipher(encrypt.CipherConf{
+		CurrentKeyId: "v1",
+		Keys:         []encrypt.CipherKey{{Id: "v1", Key: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="}},
+		IndexKey:     "aW5kZXgta2V5LWluZGV4LWtleS1pbmRleC1rZXktMzI=",
+	})
+	hashed, err := encrypt.HashPassword("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rds, _ := newTestRedis(t)
+
+	return NewLoginByPasswordLogic(context.Background(), &svc.ServiceContext{
+		UserModel: &fakePasswordUserModel{user: &model.User{
+			Id:          10,
+			MobileIndex: sql.NullString{String: cipher.BlindIndex("13800000000"), Valid: true},
+			Password:    hashed,
+		}},
+		MobileCipher:      cipher,
+		PasswordFailLimit: limit.NewFailLimit(900, 5, 900, rds, "test#"),
+	})
+}
+
+func TestLoginByPasswordLocked(t *testing.T) {
+	l := newPasswordLoginTest(t)
+
+	for i := 0; i < 5; i++ {
+		_, err := l.LoginByPassword(&service.LoginByPasswordRequest{Mobile: "13800000000", Password: "wrong"})
+		if err != code.MobileOrPasswordError {
+			t.Fatalf("attempt %d: err = %v", i, err)
+		}
+	}
+	// 超过次数后锁定，正确的密码也不再校验
+	_, err := l.LoginByPassword(&service.LoginByPasswordRequest{Mobile: "13800000000", Password: "secret"})
+	if err != code.PasswordLoginLocked {
+		t.Fatalf("err = %v, want PasswordLoginLocked", err)
+	}
+	// 不存在的手机号同样计数
+	for i := 0; i < 5; i++ {
+		l.LoginByPassword(&service.LoginByPasswordRequest{Mobile: "13900000000", Password: "wrong"})
+	}
+	_, err = l.LoginByPassword(&service.LoginByPasswordRequest{Mobile: "13900000000", Password: "wrong"})
+	if err != code.PasswordLoginLocked {
+		t.Fatalf("unknown mobile: err = %v, want PasswordLoginLocked", err)
+	}
+}
+
+func TestLoginByPasswordReset(t *testing.T) {
+	l := newPasswordLoginTest(t)
+
+	for i := 0; i < 4; i++ {
+		l.LoginByPassword(&service.LoginByPasswordRequest{Mobile: "13800000000", Password: "wrong"})
+	}
+	ret, err := l.LoginByPassword(&service.LoginByPasswordRequest{Mobile: "13800000000", Password: "secret"})
+	if err != nil || ret.UserId != 10 {
+		t.Fatalf("login: ret = %v, err = %v", ret, err)
+	}
+	// 登录成功后重新计数
+	for i := 0; i < 5; i++ {
+		_, err = l.LoginByPassword(&service.LoginByPasswordRequest{Mobile: "13800000000", Password: "wrong"})
+		if err != code.MobileOrPasswordError {
+			t.Fatalf("attempt %d after reset: err = %v", i, err)
+		}
+	}
+}
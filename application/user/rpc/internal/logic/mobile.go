@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/pkg/encrypt"
+)
+
+// findUserByMobile 先按盲索引查找，找不到时再按旧版本AES-ECB的密文查找，兼容还没有迁移的数据
+// 用户不存在时返回nil
+func findUserByMobile(ctx context.Context, svcCtx *svc.ServiceContext, mobile string) (*model.User, error) {
+	mobileIndex := sql.NullString{String: svcCtx.MobileCipher.BlindIndex(mobile), Valid: true}
+	user, err := svcCtx.UserModel.FindOneByMobileIndex(ctx, mobileIndex)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		return nil, err
+	}
+
+	legacy, err := encrypt.EncMobile(mobile)
+	if err != nil {
+		return nil, err
+	}
+	return svcCtx.UserModel.FindByMobile(ctx, legacy)
+}
@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/mail"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/sms"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	notifyChannelEmail = "email"
+	notifyChannelSms   = "sms"
+)
+
+type NotifyUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewNotifyUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *NotifyUserLogic {
+	return &NotifyUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *NotifyUserLogic) NotifyUser(in *service.NotifyUserRequest) (*service.NotifyUserResponse, error) {
+	// 1、检查参数
+	if len(in.TemplateId) == 0 {
+		return nil, code.SmsTemplateEmpty
+	}
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.UserNoContact
+		}
+		l.Logger.Errorf("FindOne userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+
+	// 2、绑定了邮箱时发送邮件
+	if user.Email.Valid && len(user.Email.String) > 0 {
+		msg, err := mail.Render(in.TemplateId, user.Email.String, in.Params)
+		if err != nil {
+			l.Logger.Errorf("Render templateId: %s error: %v", in.TemplateId, err)
+			return nil, code.EmailTemplateInvalid
+		}
+		if err = l.svcCtx.Mailer.Send(l.ctx, msg); err != nil {
+			l.Logger.Errorf("NotifyUser userId: %d templateId: %s error: %v", in.UserId, in.TemplateId, err)
+			return nil, code.EmailSendFailed
+		}
+		return &service.NotifyUserResponse{Channel: notifyChannelEmail}, nil
+	}
+
+	// 3、否则发送短信，只用邮箱注册和已注销的用户没有手机号索引
+	if !user.Mobile.Valid || !user.MobileIndex.Valid {
+		return nil, code.UserNoContact
+	}
+	mobile, err := l.svcCtx.MobileCipher.Decrypt(user.Mobile.String)
+	if err != nil {
+		l.Logger.Errorf("Decrypt mobile userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+	err = l.svcCtx.SmsSender.Send(l.ctx, &sms.Message{
+		Mobile:     mobile,
+		TemplateId: in.TemplateId,
+		Params:     in.Params,
+	})
+	if err != nil {
+		l.Logger.Errorf("NotifyUser userId: %d templateId: %s error: %v", in.UserId, in.TemplateId, err)
+		return nil, code.SmsSendFailed
+	}
+
+	return &service.NotifyUserResponse{Channel: notifyChannelSms}, nil
+}
@@ -2,13 +2,18 @@ package logic
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"strings"
 	"time"
 
 	"myBeyond/application/user/rpc/internal/code"
 	"myBeyond/application/user/rpc/internal/model"
 	"myBeyond/application/user/rpc/internal/svc"
 	"myBeyond/application/user/rpc/service"
+	"myBeyond/pkg/encrypt"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -32,15 +37,67 @@ func (l *RegisterLogic) Register(in *service.RegisterRequest) (*service.Register
 		return nil, code.RegisterNameEmpty
 	}
 
-	ret, err := l.svcCtx.UserModel.Insert(l.ctx, &model.User{
-		Username:   in.Username,
-		Mobile:     in.Mobile,
+	// 密码可以为空，为空时只能通过验证码登录
+	var password string
+	if len(in.Password) > 0 {
+		hashed, err := encrypt.HashPassword(in.Password)
+		if err != nil {
+			logx.Errorf("HashPassword error: %v", err)
+			return nil, err
+		}
+		password = hashed
+	}
+
+	// 手机号和邮箱至少有一个
+	in.Mobile = strings.TrimSpace(in.Mobile)
+	in.Email = strings.TrimSpace(in.Email)
+	if len(in.Mobile) == 0 && len(in.Email) == 0 {
+		return nil, code.MobileEmpty
+	}
+	data := &model.User{
+		Username:   l.svcCtx.Sensitive.Mask(in.Username),
 		Avatar:     in.Avatar,
+		Password:   password,
 		CreateTime: time.Now(),
 		UpdateTime: time.Now(),
-	})
+	}
+
+	// 手机号加密存储，盲索引用于按手机号查找，只用邮箱注册时手机号为NULL
+	if len(in.Mobile) > 0 {
+		mobile, err := l.svcCtx.MobileCipher.Encrypt(in.Mobile)
+		if err != nil {
+			logx.Errorf("Encrypt mobile error: %v", err)
+			return nil, err
+		}
+		data.Mobile = sql.NullString{String: mobile, Valid: true}
+		data.MobileIndex = sql.NullString{String: l.svcCtx.MobileCipher.BlindIndex(in.Mobile), Valid: true}
+	}
+
+	if len(in.Email) > 0 {
+		email, err := normalizeEmail(in.Email)
+		if err != nil {
+			return nil, err
+		}
+		u, err := findUserByEmail(l.ctx, l.svcCtx, email)
+		if err != nil {
+			logx.Errorf("FindByEmail email: %s error: %v", email, err)
+			return nil, err
+		}
+		if u != nil {
+			return nil, code.EmailHasRegistered
+		}
+		data.Email = sql.NullString{String: email, Valid: true}
+	}
+
+	// 需要绑定第三方身份时与创建用户放在同一个事务中
+	if in.Identity != nil {
+		return l.registerWithIdentity(data, in.Identity)
+	}
+
+	ret, err := l.svcCtx.UserModel.Insert(l.ctx, data)
 	if err != nil {
-		logx.Errorf("Register req: %v error: %v", in, err)
+		// 请求中包含明文的手机号和密码，不能直接打印
+		logx.Errorf("Register username: %s error: %v", in.Username, err)
 		return nil, err
 	}
 	userId, err := ret.LastInsertId()
@@ -51,3 +108,29 @@ func (l *RegisterLogic) Register(in *service.RegisterRequest) (*service.Register
 
 	return &service.RegisterResponse{UserId: userId}, nil
 }
+
+func (l *RegisterLogic) registerWithIdentity(data *model.User, in *service.RegisterIdentity) (*service.RegisterResponse, error) {
+	identity := &model.UserIdentity{
+		Provider: strings.TrimSpace(in.Provider),
+		OpenId:   strings.TrimSpace(in.OpenId),
+		UnionId:  strings.TrimSpace(in.UnionId),
+	}
+	if len(identity.Provider) == 0 || len(identity.OpenId) == 0 {
+		return nil, code.IdentityParamEmpty
+	}
+
+	userId, err := l.svcCtx.UserModel.InsertWithIdentity(l.ctx, data, identity)
+	if err != nil {
+		// 身份已经绑定了其他用户，事务回滚，用户也不会创建
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry {
+			if bound, _ := l.svcCtx.IdentityModel.FindOneByProviderOpenId(l.ctx, identity.Provider, identity.OpenId); bound != nil {
+				return nil, code.IdentityAlreadyBound
+			}
+		}
+		logx.Errorf("InsertWithIdentity provider: %s openId: %s error: %v", identity.Provider, identity.OpenId, err)
+		return nil, err
+	}
+
+	return &service.RegisterResponse{UserId: userId}, nil
+}
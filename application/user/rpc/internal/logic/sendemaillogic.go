@@ -0,0 +1,47 @@
+package logic
+
+import (
+	"context"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/mail"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendEmailLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendEmailLogic {
+	return &SendEmailLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *SendEmailLogic) SendEmail(in *service.SendEmailRequest) (*service.SendEmailResponse, error) {
+	// 1、检查参数，按模板生成邮件
+	email, err := normalizeEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := mail.Render(in.TemplateId, email, in.Params)
+	if err != nil {
+		l.Logger.Errorf("Render templateId: %s error: %v", in.TemplateId, err)
+		return nil, code.EmailTemplateInvalid
+	}
+
+	// 2、通过配置的邮件服务发送
+	if err = l.svcCtx.Mailer.Send(l.ctx, msg); err != nil {
+		l.Logger.Errorf("SendEmail email: %s templateId: %s error: %v", email, in.TemplateId, err)
+		return nil, code.EmailSendFailed
+	}
+
+	return &service.SendEmailResponse{}, nil
+}
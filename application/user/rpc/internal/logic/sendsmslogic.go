@@ -2,8 +2,9 @@ package logic
 
 import (
 	"context"
-	"fmt"
 
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/sms"
 	"myBeyond/application/user/rpc/internal/svc"
 	"myBeyond/application/user/rpc/service"
 
@@ -25,7 +26,24 @@ func NewSendSmsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendSmsLo
 }
 
 func (l *SendSmsLogic) SendSms(in *service.SendSmsRequest) (*service.SendSmsResponse, error) {
-	// todo: add your logic here and delete this line
-	fmt.Println("{{{{{{{{{{{{{{{{{{}}}}}}}}}}}}}}}}}}")
+	// 1、检查参数
+	if len(in.Mobile) == 0 {
+		return nil, code.SmsMobileEmpty
+	}
+	if len(in.TemplateId) == 0 {
+		return nil, code.SmsTemplateEmpty
+	}
+
+	// 2、通过配置的短信服务商发送
+	err := l.svcCtx.SmsSender.Send(l.ctx, &sms.Message{
+		Mobile:     in.Mobile,
+		TemplateId: in.TemplateId,
+		Params:     in.Params,
+	})
+	if err != nil {
+		l.Logger.Errorf("SendSms mobile: %s templateId: %s error: %v", in.Mobile, in.TemplateId, err)
+		return nil, code.SmsSendFailed
+	}
+
 	return &service.SendSmsResponse{}, nil
 }
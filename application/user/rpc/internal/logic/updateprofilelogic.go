@@ -0,0 +1,113 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"myBeyond/application/user/rpc/internal/code"
+	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/svc"
+	"myBeyond/application/user/rpc/service"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	minUsernameLen = 2
+	maxUsernameLen = 32
+	maxAvatarLen   = 256
+)
+
+type UpdateProfileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateProfileLogic {
+	return &UpdateProfileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateProfileLogic) UpdateProfile(in *service.UpdateProfileRequest) (*service.UpdateProfileResponse, error) {
+	// 1、查找用户
+	user, err := l.svcCtx.UserModel.FindOne(l.ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, code.UserNotExist
+		}
+		l.Logger.Errorf("FindOne userId: %d error: %v", in.UserId, err)
+		return nil, err
+	}
+
+	// 2、只修改请求中设置了的字段
+	// 用户名是展示用的昵称，登录使用手机号或邮箱，允许重名：
+	// 自动注册的用户使用相同的默认名字，注销的用户统一改为AnonymousUsername
+	username, avatar := user.Username, user.Avatar
+	if in.Username != nil {
+		username = strings.TrimSpace(*in.Username)
+		if !validUsername(username) {
+			return nil, code.UsernameInvalid
+		}
+		username = l.svcCtx.Sensitive.Mask(username)
+	}
+	if in.Avatar != nil {
+		avatar = strings.TrimSpace(*in.Avatar)
+		if !validAvatar(avatar) {
+			return nil, code.AvatarInvalid
+		}
+	}
+
+	// 3、没有变化时直接返回
+	if username != user.Username || avatar != user.Avatar {
+		err = l.svcCtx.UserModel.UpdateProfile(l.ctx, user.Id, username, avatar)
+		if err != nil {
+			l.Logger.Errorf("UpdateProfile userId: %d error: %v", user.Id, err)
+			return nil, err
+		}
+	}
+
+	return &service.UpdateProfileResponse{
+		UserId:   user.Id,
+		Username: username,
+		Avatar:   avatar,
+	}, nil
+}
+
+// 用户名长度在[minUsernameLen, maxUsernameLen]之间，不能包含空白和控制字符
+func validUsername(username string) bool {
+	n := utf8.RuneCountInString(username)
+	if n < minUsernameLen || n > maxUsernameLen {
+		return false
+	}
+	for _, r := range username {
+		if unicode.IsSpace(r) || unicode.IsControl(r) || r == utf8.RuneError {
+			return false
+		}
+	}
+
+	return true
+}
+
+// 头像为空表示清除头像，否则必须是http(s)地址
+func validAvatar(avatar string) bool {
+	if len(avatar) == 0 {
+		return true
+	}
+	if len(avatar) > maxAvatarLen {
+		return false
+	}
+	u, err := url.Parse(avatar)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && len(u.Host) > 0
+}
@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileMailer 将邮件写入文件或标准输出，用于本地开发和测试
+type FileMailer struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileMailer(path string) *FileMailer {
+	return &FileMailer{path: path}
+}
+
+func (m *FileMailer) Send(_ context.Context, msg *Message) error {
+	if err := checkHeader(msg.To, msg.Subject); err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(struct {
+		*Message
+		SendTime string `json:"send_time"`
+	}{
+		Message:  msg,
+		SendTime: time.Now().Format(time.DateTime),
+	})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var w io.Writer = os.Stdout
+	if len(m.path) > 0 {
+		f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	_, err = w.Write(line)
+	return err
+}
@@ -0,0 +1,83 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	ProviderFile = "file"
+	ProviderSmtp = "smtp"
+)
+
+var ErrHeaderInjection = errors.New("mail header contains line break")
+
+type (
+	// Mailer 邮件发送接口，本地开发使用文件，线上使用smtp
+	Mailer interface {
+		Send(ctx context.Context, msg *Message) error
+	}
+
+	Message struct {
+		To      string `json:"to"`
+		Subject string `json:"subject"`
+		Body    string `json:"body"` // 纯文本
+	}
+
+	FileConf struct {
+		Path string `json:",optional"` // 为空时输出到标准输出
+	}
+
+	SmtpConf struct {
+		Host     string `json:",optional"`
+		Port     int    `json:",default=587"`
+		Username string `json:",optional"`
+		Password string `json:",optional"`
+		From     string `json:",optional"`
+		// 465端口使用隐式TLS，其他端口在服务端支持时使用STARTTLS
+		TLS     bool  `json:",optional"`
+		Timeout int64 `json:",default=5000"` // 毫秒
+	}
+
+	Config struct {
+		Provider string   `json:",default=file,options=file|smtp"`
+		File     FileConf `json:",optional"`
+		Smtp     SmtpConf `json:",optional"`
+	}
+)
+
+func NewMailer(c Config) (Mailer, error) {
+	switch c.Provider {
+	case ProviderFile:
+		return NewFileMailer(c.File.Path), nil
+	case ProviderSmtp:
+		if len(c.Smtp.Host) == 0 || len(c.Smtp.From) == 0 {
+			return nil, fmt.Errorf("mail smtp host or from is empty")
+		}
+		return NewSmtpMailer(c.Smtp), nil
+	}
+
+	return nil, fmt.Errorf("unknown mail provider: %s", c.Provider)
+}
+
+func MustNewMailer(c Config) Mailer {
+	mailer, err := NewMailer(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return mailer
+}
+
+// 收件人和主题会写入邮件头，不能包含换行
+func checkHeader(values ...string) error {
+	for _, v := range values {
+		if strings.ContainsAny(v, "\r\n") {
+			return ErrHeaderInjection
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileMailer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.log")
+	mailer, err := NewMailer(Config{Provider: ProviderFile, File: FileConf{Path: path}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg, err := Render("email_verification", "tester@example.com", map[string]string{"code": "123456"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = mailer.Send(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"to":"tester@example.com"`) || !strings.Contains(string(data), "123456") {
+		t.Fatalf("unexpected mail log: %s", data)
+	}
+
+	err = mailer.Send(context.Background(), &Message{To: "a@example.com\r\nBcc: b@example.com"})
+	if err != ErrHeaderInjection {
+		t.Fatalf("expected ErrHeaderInjection, got %v", err)
+	}
+}
+
+func TestRender(t *testing.T) {
+	msg, err := Render("email_verification", "tester@example.com", map[string]string{
+		"code": "123456",
+		"link": "https://example.com/login/email?code=123456",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(msg.Body, "https://example.com/login/email?code=123456") {
+		t.Fatalf("link not rendered: %s", msg.Body)
+	}
+
+	msg, err = Render("article_audit", "tester@example.com", map[string]string{
+		"title":  "文章测试",
+		"result": "审核不通过",
+		"reason": "包含广告",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(msg.Body, "《文章测试》审核不通过") || !strings.Contains(msg.Body, "原因：包含广告") {
+		t.Fatalf("unexpected body: %s", msg.Body)
+	}
+
+	if _, err = Render("unknown", "tester@example.com", nil); err == nil {
+		t.Fatal("expected error for unknown template")
+	}
+}
+
+func TestSmtpMailer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	// 最简单的smtp服务端，不支持STARTTLS和AUTH
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+		reply("220 localhost")
+		var data strings.Builder
+		inData := false
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if inData {
+				if line == ".\r\n" {
+					inData = false
+					received <- data.String()
+					reply("250 ok")
+					continue
+				}
+				data.WriteString(line)
+				continue
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"):
+				reply("250 localhost")
+			case cmd == "DATA":
+				inData = true
+				reply("354 go ahead")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+
+	addr := ln.Addr().(*net.TCPAddr)
+	mailer := NewSmtpMailer(SmtpConf{Host: "127.0.0.1", Port: addr.Port, From: "noreply@example.com", Timeout: 3000})
+	err = mailer.Send(context.Background(), &Message{To: "tester@example.com", Subject: "验证码", Body: "123456"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case data := <-received:
+		if !strings.Contains(data, "To: tester@example.com\r\n") {
+			t.Fatalf("unexpected headers: %s", data)
+		}
+		if !strings.Contains(data, base64.StdEncoding.EncodeToString([]byte("123456"))) {
+			t.Fatalf("unexpected body: %s", data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("mail not received")
+	}
+}
@@ -0,0 +1,113 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SmtpMailer 通过smtp服务器发送纯文本邮件
+type SmtpMailer struct {
+	c       SmtpConf
+	timeout time.Duration
+}
+
+func NewSmtpMailer(c SmtpConf) *SmtpMailer {
+	return &SmtpMailer{
+		c:       c,
+		timeout: time.Duration(c.Timeout) * time.Millisecond,
+	}
+}
+
+func (m *SmtpMailer) Send(ctx context.Context, msg *Message) error {
+	if err := checkHeader(msg.To, msg.Subject); err != nil {
+		return err
+	}
+
+	// 1、建立连接，整个发送过程受超时时间限制
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+	addr := net.JoinHostPort(m.c.Host, strconv.Itoa(m.c.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	tlsConfig := &tls.Config{ServerName: m.c.Host}
+	if m.c.TLS {
+		conn = tls.Client(conn, tlsConfig)
+	}
+	client, err := smtp.NewClient(conn, m.c.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	// 2、升级TLS并登录
+	if !m.c.TLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err = client.StartTLS(tlsConfig); err != nil {
+				return err
+			}
+		}
+	}
+	if len(m.c.Username) > 0 {
+		if err = client.Auth(smtp.PlainAuth("", m.c.Username, m.c.Password, m.c.Host)); err != nil {
+			return err
+		}
+	}
+
+	// 3、发送邮件
+	if err = client.Mail(m.c.From); err != nil {
+		return err
+	}
+	if err = client.Rcpt(msg.To); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(buildMessage(m.c.From, msg, time.Now())); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// 组装邮件内容，主题和正文使用UTF-8编码，正文base64编码后每行76个字符
+func buildMessage(from string, msg *Message, now time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	body := base64.StdEncoding.EncodeToString([]byte(msg.Body))
+	for len(body) > 76 {
+		buf.WriteString(body[:76])
+		buf.WriteString("\r\n")
+		body = body[76:]
+	}
+	buf.WriteString(body)
+	buf.WriteString("\r\n")
+
+	return buf.Bytes()
+}
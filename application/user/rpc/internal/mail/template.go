@@ -0,0 +1,46 @@
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// 内置的邮件模板，params中的值通过{{.key}}引用
+var templates = map[string]struct {
+	subject string
+	body    *template.Template
+}{
+	"email_verification": {
+		subject: "Beyond 验证码",
+		body: template.Must(template.New("email_verification").Parse(
+			"您的验证码是 {{.code}}，30分钟内有效，请勿泄露给他人。\n" +
+				"{{if .link}}也可以在手机上打开以下链接直接完成验证：\n{{.link}}\n{{end}}" +
+				"如果不是您本人操作，请忽略本邮件。\n")),
+	},
+	"article_audit": {
+		subject: "Beyond 文章审核结果",
+		body: template.Must(template.New("article_audit").Parse(
+			"您的文章《{{.title}}》{{.result}}。\n" +
+				"{{if .reason}}原因：{{.reason}}\n{{end}}")),
+	},
+}
+
+// Render 按模板生成邮件，模板不存在时返回错误
+func Render(templateId string, to string, params map[string]string) (*Message, error) {
+	tpl, ok := templates[templateId]
+	if !ok {
+		return nil, fmt.Errorf("unknown mail template: %s", templateId)
+	}
+
+	var body bytes.Buffer
+	if err := tpl.body.Execute(&body, params); err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		To:      to,
+		Subject: tpl.subject,
+		Body:    body.String(),
+	}, nil
+}
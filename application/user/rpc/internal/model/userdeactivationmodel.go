@@ -0,0 +1,70 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 注销状态
+const (
+	DeactivationStatusPending  = iota + 1 // 冷静期
+	DeactivationStatusCanceled            // 冷静期内重新登录，已取消
+	DeactivationStatusDeleting            // 冷静期结束，正在清理数据
+	DeactivationStatusDeleted             // 已注销
+)
+
+var _ UserDeactivationModel = (*customUserDeactivationModel)(nil)
+
+type (
+	// UserDeactivationModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserDeactivationModel.
+	UserDeactivationModel interface {
+		userDeactivationModel
+		FindDue(ctx context.Context, now time.Time, limit int) ([]*UserDeactivation, error)
+		CompareAndSetStatus(ctx context.Context, data *UserDeactivation, from, to int64) (bool, error)
+	}
+
+	customUserDeactivationModel struct {
+		*defaultUserDeactivationModel
+	}
+)
+
+// NewUserDeactivationModel returns a model for the database table.
+func NewUserDeactivationModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserDeactivationModel {
+	return &customUserDeactivationModel{
+		defaultUserDeactivationModel: newUserDeactivationModel(conn, c, opts...),
+	}
+}
+
+// FindDue 查询冷静期已经结束，或者上次清理没有完成的注销记录，不经过缓存
+func (m *customUserDeactivationModel) FindDue(ctx context.Context, now time.Time, limit int) ([]*UserDeactivation, error) {
+	var list []*UserDeactivation
+	query := fmt.Sprintf("select %s from %s where (`status` = ? and `delete_time` <= ?) or `status` = ? order by `id` limit ?",
+		userDeactivationRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &list, query, DeactivationStatusPending, now, DeactivationStatusDeleting, limit)
+	return list, err
+}
+
+// CompareAndSetStatus 只有当前状态为from时才修改为to，用于取消注销和清理任务之间的并发控制
+func (m *customUserDeactivationModel) CompareAndSetStatus(ctx context.Context, data *UserDeactivation, from, to int64) (bool, error) {
+	idKey := fmt.Sprintf("%s%v", cacheBeyondUserUserDeactivationIdPrefix, data.Id)
+	userIdKey := fmt.Sprintf("%s%v", cacheBeyondUserUserDeactivationUserIdPrefix, data.UserId)
+	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table)
+		return conn.ExecCtx(ctx, query, to, data.Id, from)
+	}, idKey, userIdKey)
+	if err != nil {
+		return false, err
+	}
+	rows, err := ret.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ UserIdentityModel = (*customUserIdentityModel)(nil)
+
+type (
+	// UserIdentityModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customUserIdentityModel.
+	UserIdentityModel interface {
+		userIdentityModel
+		FindOneByProviderUnionId(ctx context.Context, provider, unionId string) (*UserIdentity, error)
+		FindByUserId(ctx context.Context, userId int64) ([]*UserIdentity, error)
+	}
+
+	customUserIdentityModel struct {
+		*defaultUserIdentityModel
+	}
+)
+
+// NewUserIdentityModel returns a model for the database table.
+func NewUserIdentityModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) UserIdentityModel {
+	return &customUserIdentityModel{
+		defaultUserIdentityModel: newUserIdentityModel(conn, c, opts...),
+	}
+}
+
+// FindOneByProviderUnionId 同一个开放平台下不同应用的openid不同，但unionid相同
+func (m *customUserIdentityModel) FindOneByProviderUnionId(ctx context.Context, provider, unionId string) (*UserIdentity, error) {
+	var identity UserIdentity
+	query := fmt.Sprintf("select %s from %s where `provider` = ? and `union_id` = ? limit 1", userIdentityRows, m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &identity, query, provider, unionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity, nil
+}
+
+// FindByUserId 查询用户绑定的所有第三方身份
+func (m *customUserIdentityModel) FindByUserId(ctx context.Context, userId int64) ([]*UserIdentity, error) {
+	var list []*UserIdentity
+	query := fmt.Sprintf("select %s from %s where `user_id` = ?", userIdentityRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &list, query, userId)
+	return list, err
+}
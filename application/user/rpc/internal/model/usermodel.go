@@ -2,12 +2,18 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
+// AnonymousUsername 注销用户的用户名，用户名允许重复，所有注销的用户使用同一个名字
+const AnonymousUsername = "已注销用户"
+
 // 检查 customUserModel 类型是否实现了 UserModel 接口
 var _ UserModel = (*customUserModel)(nil)
 
@@ -17,6 +23,14 @@ type (
 	UserModel interface {
 		userModel
 		FindByMobile(ctx context.Context, mobile string) (*User, error)
+		UpdateProfile(ctx context.Context, id int64, username, avatar string) error
+		FindByIds(ctx context.Context, ids []int64) (map[int64]*User, error)
+		UpdateMobile(ctx context.Context, id int64, mobile, mobileIndex string) error
+		UpdatePassword(ctx context.Context, id int64, password string) error
+		FindAfterId(ctx context.Context, id int64, limit int) ([]*User, error)
+		Anonymize(ctx context.Context, id int64) error
+		UpdateEmail(ctx context.Context, id int64, email string) error
+		InsertWithIdentity(ctx context.Context, data *User, identity *UserIdentity) (int64, error)
 	}
 
 	customUserModel struct {
@@ -43,3 +57,168 @@ func (m *customUserModel) FindByMobile(ctx context.Context, mobile string) (*Use
 
 	return &user, nil
 }
+
+// UpdateProfile 只修改用户名和头像，同时删除用户的行缓存
+func (m *customUserModel) UpdateProfile(ctx context.Context, id int64, username, avatar string) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `username` = ?, `avatar` = ? where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, username, avatar, id)
+	}, m.cacheKeys(data)...)
+	return err
+}
+
+// FindByIds 先读id对应的行缓存，未命中的用户再通过一次in查询获取并回写缓存
+func (m *customUserModel) FindByIds(ctx context.Context, ids []int64) (map[int64]*User, error) {
+	users := make(map[int64]*User, len(ids))
+	var missIds []int64
+	for _, id := range ids {
+		var user User
+		key := fmt.Sprintf("%s%v", cacheBeyondUserUserIdPrefix, id)
+		if err := m.GetCacheCtx(ctx, key, &user); err != nil {
+			missIds = append(missIds, id)
+			continue
+		}
+		users[id] = &user
+	}
+	if len(missIds) == 0 {
+		return users, nil
+	}
+
+	var list []*User
+	args := make([]any, 0, len(missIds))
+	for _, id := range missIds {
+		args = append(args, id)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(missIds)), ",")
+	query := fmt.Sprintf("select %s from %s where `id` in (%s)", userRows, m.table, placeholders)
+	if err := m.QueryRowsNoCacheCtx(ctx, &list, query, args...); err != nil {
+		return nil, err
+	}
+	for _, user := range list {
+		users[user.Id] = user
+		key := fmt.Sprintf("%s%v", cacheBeyondUserUserIdPrefix, user.Id)
+		if err := m.SetCacheCtx(ctx, key, user); err != nil {
+			logx.WithContext(ctx).Errorf("SetCacheCtx key: %s error: %v", key, err)
+		}
+	}
+
+	return users, nil
+}
+
+// UpdateMobile 修改手机号密文和盲索引，同时删除新旧手机号对应的缓存
+func (m *customUserModel) UpdateMobile(ctx context.Context, id int64, mobile, mobileIndex string) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	newMobile := sql.NullString{String: mobile, Valid: true}
+	newMobileIndex := sql.NullString{String: mobileIndex, Valid: true}
+	keys := append(m.cacheKeys(data),
+		fmt.Sprintf("%s%v", cacheBeyondUserUserMobilePrefix, newMobile),
+		fmt.Sprintf("%s%v", cacheBeyondUserUserMobileIndexPrefix, newMobileIndex))
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `mobile` = ?, `mobile_index` = ? where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, newMobile, newMobileIndex, id)
+	}, keys...)
+	return err
+}
+
+// UpdatePassword 修改密码哈希，同时删除用户的行缓存
+func (m *customUserModel) UpdatePassword(ctx context.Context, id int64, password string) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `password` = ? where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, password, id)
+	}, m.cacheKeys(data)...)
+	return err
+}
+
+// FindAfterId 按id顺序分批遍历用户，不经过缓存
+func (m *customUserModel) FindAfterId(ctx context.Context, id int64, limit int) ([]*User, error) {
+	var users []*User
+	query := fmt.Sprintf("select %s from %s where `id` > ? order by `id` limit ?", userRows, m.table)
+	err := m.QueryRowsNoCacheCtx(ctx, &users, query, id, limit)
+	return users, err
+}
+
+// Anonymize 注销后清除用户的个人信息，手机号和邮箱置为NULL，不占用唯一索引
+func (m *customUserModel) Anonymize(ctx context.Context, id int64) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `username` = ?, `avatar` = '', `mobile` = NULL, `mobile_index` = NULL, `email` = NULL, `password` = '' where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, AnonymousUsername, id)
+	}, m.cacheKeys(data)...)
+	return err
+}
+
+// UpdateEmail 绑定或修改邮箱，同时删除新旧邮箱对应的缓存
+func (m *customUserModel) UpdateEmail(ctx context.Context, id int64, email string) error {
+	data, err := m.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	newEmail := sql.NullString{String: email, Valid: true}
+	keys := append(m.cacheKeys(data), fmt.Sprintf("%s%v", cacheBeyondUserUserEmailPrefix, newEmail))
+	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `email` = ? where `id` = ?", m.table)
+		return conn.ExecCtx(ctx, query, newEmail, id)
+	}, keys...)
+	return err
+}
+
+// InsertWithIdentity 在同一个事务中创建用户并绑定第三方身份，绑定失败时不会留下没有身份的用户
+func (m *customUserModel) InsertWithIdentity(ctx context.Context, data *User, identity *UserIdentity) (int64, error) {
+	var userId int64
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?)", m.table, userRowsExpectAutoSet)
+		ret, err := session.ExecCtx(ctx, query, data.Username, data.Avatar, data.Mobile, data.MobileIndex, data.Email, data.Password)
+		if err != nil {
+			return err
+		}
+		if userId, err = ret.LastInsertId(); err != nil {
+			return err
+		}
+
+		query = fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?)", "`user_identity`", userIdentityRowsExpectAutoSet)
+		_, err = session.ExecCtx(ctx, query, userId, identity.Provider, identity.OpenId, identity.UnionId)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// 删除查询不存在时缓存的占位值
+	data.Id = userId
+	keys := append(m.cacheKeys(data),
+		fmt.Sprintf("%s%v:%v", cacheBeyondUserUserIdentityProviderOpenIdPrefix, identity.Provider, identity.OpenId))
+	if err = m.DelCacheCtx(ctx, keys...); err != nil {
+		logx.WithContext(ctx).Errorf("DelCacheCtx keys: %v error: %v", keys, err)
+	}
+
+	return userId, nil
+}
+
+// 用户一行数据对应的所有缓存键
+func (m *customUserModel) cacheKeys(data *User) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheBeyondUserUserIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheBeyondUserUserMobilePrefix, data.Mobile),
+		fmt.Sprintf("%s%v", cacheBeyondUserUserMobileIndexPrefix, data.MobileIndex),
+		fmt.Sprintf("%s%v", cacheBeyondUserUserEmailPrefix, data.Email),
+	}
+}
@@ -22,15 +22,19 @@ var (
 	userRowsExpectAutoSet   = strings.Join(stringx.Remove(userFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
 	userRowsWithPlaceHolder = strings.Join(stringx.Remove(userFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
 
-	cacheBeyondUserUserIdPrefix     = "cache:beyondUser:user:id:"
-	cacheBeyondUserUserMobilePrefix = "cache:beyondUser:user:mobile:"
+	cacheBeyondUserUserEmailPrefix       = "cache:beyondUser:user:email:"
+	cacheBeyondUserUserIdPrefix          = "cache:beyondUser:user:id:"
+	cacheBeyondUserUserMobilePrefix      = "cache:beyondUser:user:mobile:"
+	cacheBeyondUserUserMobileIndexPrefix = "cache:beyondUser:user:mobileIndex:"
 )
 
 type (
 	userModel interface {
 		Insert(ctx context.Context, data *User) (sql.Result, error)
 		FindOne(ctx context.Context, id int64) (*User, error)
-		FindOneByMobile(ctx context.Context, mobile string) (*User, error)
+		FindOneByEmail(ctx context.Context, email sql.NullString) (*User, error)
+		FindOneByMobile(ctx context.Context, mobile sql.NullString) (*User, error)
+		FindOneByMobileIndex(ctx context.Context, mobileIndex sql.NullString) (*User, error)
 		Update(ctx context.Context, data *User) error
 		Delete(ctx context.Context, id int64) error
 	}
@@ -41,12 +45,15 @@ type (
 	}
 
 	User struct {
-		Id         int64     `db:"id"` // ID
-		Username   string    `db:"username"`
-		Avatar     string    `db:"avatar"`
-		Mobile     string    `db:"mobile"`
-		CreateTime time.Time `db:"create_time"`
-		UpdateTime time.Time `db:"update_time"`
+		Id          int64          `db:"id"` // ID
+		Username    string         `db:"username"`
+		Avatar      string         `db:"avatar"`
+		Mobile      sql.NullString `db:"mobile"`
+		MobileIndex sql.NullString `db:"mobile_index"`
+		Email       sql.NullString `db:"email"`
+		Password    string         `db:"password"`
+		CreateTime  time.Time      `db:"create_time"`
+		UpdateTime  time.Time      `db:"update_time"`
 	}
 )
 
@@ -70,12 +77,14 @@ func (m *defaultUserModel) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
+	beyondUserUserEmailKey := fmt.Sprintf("%s%v", cacheBeyondUserUserEmailPrefix, data.Email)
 	beyondUserUserIdKey := fmt.Sprintf("%s%v", cacheBeyondUserUserIdPrefix, id)
+	beyondUserUserMobileIndexKey := fmt.Sprintf("%s%v", cacheBeyondUserUserMobileIndexPrefix, data.MobileIndex)
 	beyondUserUserMobileKey := fmt.Sprintf("%s%v", cacheBeyondUserUserMobilePrefix, data.Mobile)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
 		return conn.ExecCtx(ctx, query, id)
-	}, beyondUserUserIdKey, beyondUserUserMobileKey)
+	}, beyondUserUserEmailKey, beyondUserUserIdKey, beyondUserUserMobileIndexKey, beyondUserUserMobileKey)
 	return err
 }
 
@@ -96,7 +105,27 @@ func (m *defaultUserModel) FindOne(ctx context.Context, id int64) (*User, error)
 	}
 }
 
-func (m *defaultUserModel) FindOneByMobile(ctx context.Context, mobile string) (*User, error) {
+func (m *defaultUserModel) FindOneByEmail(ctx context.Context, email sql.NullString) (*User, error) {
+	beyondUserUserEmailKey := fmt.Sprintf("%s%v", cacheBeyondUserUserEmailPrefix, email)
+	var resp User
+	err := m.QueryRowIndexCtx(ctx, &resp, beyondUserUserEmailKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `email` = ? limit 1", userRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, email); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *defaultUserModel) FindOneByMobile(ctx context.Context, mobile sql.NullString) (*User, error) {
 	beyondUserUserMobileKey := fmt.Sprintf("%s%v", cacheBeyondUserUserMobilePrefix, mobile)
 	var resp User
 	err := m.QueryRowIndexCtx(ctx, &resp, beyondUserUserMobileKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
@@ -116,13 +145,35 @@ func (m *defaultUserModel) FindOneByMobile(ctx context.Context, mobile string) (
 	}
 }
 
+func (m *defaultUserModel) FindOneByMobileIndex(ctx context.Context, mobileIndex sql.NullString) (*User, error) {
+	beyondUserUserMobileIndexKey := fmt.Sprintf("%s%v", cacheBeyondUserUserMobileIndexPrefix, mobileIndex)
+	var resp User
+	err := m.QueryRowIndexCtx(ctx, &resp, beyondUserUserMobileIndexKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `mobile_index` = ? limit 1", userRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, mobileIndex); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
 func (m *defaultUserModel) Insert(ctx context.Context, data *User) (sql.Result, error) {
+	beyondUserUserEmailKey := fmt.Sprintf("%s%v", cacheBeyondUserUserEmailPrefix, data.Email)
 	beyondUserUserIdKey := fmt.Sprintf("%s%v", cacheBeyondUserUserIdPrefix, data.Id)
+	beyondUserUserMobileIndexKey := fmt.Sprintf("%s%v", cacheBeyondUserUserMobileIndexPrefix, data.MobileIndex)
 	beyondUserUserMobileKey := fmt.Sprintf("%s%v", cacheBeyondUserUserMobilePrefix, data.Mobile)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?)", m.table, userRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.Username, data.Avatar, data.Mobile)
-	}, beyondUserUserIdKey, beyondUserUserMobileKey)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?)", m.table, userRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.Username, data.Avatar, data.Mobile, data.MobileIndex, data.Email, data.Password)
+	}, beyondUserUserEmailKey, beyondUserUserIdKey, beyondUserUserMobileIndexKey, beyondUserUserMobileKey)
 	return ret, err
 }
 
@@ -132,12 +183,14 @@ func (m *defaultUserModel) Update(ctx context.Context, newData *User) error {
 		return err
 	}
 
+	beyondUserUserEmailKey := fmt.Sprintf("%s%v", cacheBeyondUserUserEmailPrefix, data.Email)
 	beyondUserUserIdKey := fmt.Sprintf("%s%v", cacheBeyondUserUserIdPrefix, data.Id)
+	beyondUserUserMobileIndexKey := fmt.Sprintf("%s%v", cacheBeyondUserUserMobileIndexPrefix, data.MobileIndex)
 	beyondUserUserMobileKey := fmt.Sprintf("%s%v", cacheBeyondUserUserMobilePrefix, data.Mobile)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, userRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.Username, newData.Avatar, newData.Mobile, newData.Id)
-	}, beyondUserUserIdKey, beyondUserUserMobileKey)
+		return conn.ExecCtx(ctx, query, newData.Username, newData.Avatar, newData.Mobile, newData.MobileIndex, newData.Email, newData.Password, newData.Id)
+	}, beyondUserUserEmailKey, beyondUserUserIdKey, beyondUserUserMobileIndexKey, beyondUserUserMobileKey)
 	return err
 }
 
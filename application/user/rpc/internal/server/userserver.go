@@ -32,6 +32,11 @@ func (s *UserServer) FindById(ctx context.Context, in *service.FindByIdRequest)
 	return l.FindById(in)
 }
 
+func (s *UserServer) FindByIds(ctx context.Context, in *service.FindByIdsRequest) (*service.FindByIdsResponse, error) {
+	l := logic.NewFindByIdsLogic(ctx, s.svcCtx)
+	return l.FindByIds(in)
+}
+
 func (s *UserServer) FindByMobile(ctx context.Context, in *service.FindByMobileRequest) (*service.FindByMobileResponse, error) {
 	l := logic.NewFindByMobileLogic(ctx, s.svcCtx)
 	return l.FindByMobile(in)
@@ -41,3 +46,58 @@ func (s *UserServer) SendSms(ctx context.Context, in *service.SendSmsRequest) (*
 	l := logic.NewSendSmsLogic(ctx, s.svcCtx)
 	return l.SendSms(in)
 }
+
+func (s *UserServer) LoginByPassword(ctx context.Context, in *service.LoginByPasswordRequest) (*service.LoginByPasswordResponse, error) {
+	l := logic.NewLoginByPasswordLogic(ctx, s.svcCtx)
+	return l.LoginByPassword(in)
+}
+
+func (s *UserServer) UpdateProfile(ctx context.Context, in *service.UpdateProfileRequest) (*service.UpdateProfileResponse, error) {
+	l := logic.NewUpdateProfileLogic(ctx, s.svcCtx)
+	return l.UpdateProfile(in)
+}
+
+func (s *UserServer) ChangeMobile(ctx context.Context, in *service.ChangeMobileRequest) (*service.ChangeMobileResponse, error) {
+	l := logic.NewChangeMobileLogic(ctx, s.svcCtx)
+	return l.ChangeMobile(in)
+}
+
+func (s *UserServer) FindByIdentity(ctx context.Context, in *service.FindByIdentityRequest) (*service.FindByIdentityResponse, error) {
+	l := logic.NewFindByIdentityLogic(ctx, s.svcCtx)
+	return l.FindByIdentity(in)
+}
+
+func (s *UserServer) BindIdentity(ctx context.Context, in *service.BindIdentityRequest) (*service.BindIdentityResponse, error) {
+	l := logic.NewBindIdentityLogic(ctx, s.svcCtx)
+	return l.BindIdentity(in)
+}
+
+func (s *UserServer) DeactivateAccount(ctx context.Context, in *service.DeactivateAccountRequest) (*service.DeactivateAccountResponse, error) {
+	l := logic.NewDeactivateAccountLogic(ctx, s.svcCtx)
+	return l.DeactivateAccount(in)
+}
+
+func (s *UserServer) CancelDeactivation(ctx context.Context, in *service.CancelDeactivationRequest) (*service.CancelDeactivationResponse, error) {
+	l := logic.NewCancelDeactivationLogic(ctx, s.svcCtx)
+	return l.CancelDeactivation(in)
+}
+
+func (s *UserServer) FindByEmail(ctx context.Context, in *service.FindByEmailRequest) (*service.FindByEmailResponse, error) {
+	l := logic.NewFindByEmailLogic(ctx, s.svcCtx)
+	return l.FindByEmail(in)
+}
+
+func (s *UserServer) BindEmail(ctx context.Context, in *service.BindEmailRequest) (*service.BindEmailResponse, error) {
+	l := logic.NewBindEmailLogic(ctx, s.svcCtx)
+	return l.BindEmail(in)
+}
+
+func (s *UserServer) SendEmail(ctx context.Context, in *service.SendEmailRequest) (*service.SendEmailResponse, error) {
+	l := logic.NewSendEmailLogic(ctx, s.svcCtx)
+	return l.SendEmail(in)
+}
+
+func (s *UserServer) NotifyUser(ctx context.Context, in *service.NotifyUserRequest) (*service.NotifyUserResponse, error) {
+	l := logic.NewNotifyUserLogic(ctx, s.svcCtx)
+	return l.NotifyUser(in)
+}
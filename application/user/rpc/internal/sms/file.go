@@ -0,0 +1,50 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileSender 将短信写入文件或标准输出，用于本地开发和测试
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+func (s *FileSender) Send(_ context.Context, msg *Message) error {
+	line, err := json.Marshal(struct {
+		*Message
+		SendTime string `json:"send_time"`
+	}{
+		Message:  msg,
+		SendTime: time.Now().Format(time.DateTime),
+	})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var w io.Writer = os.Stdout
+	if len(s.path) > 0 {
+		f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	_, err = w.Write(line)
+	return err
+}
@@ -0,0 +1,67 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HttpSender 通过http接口发送短信，本地可以指向一个stub服务
+type HttpSender struct {
+	endpoint  string
+	appKey    string
+	appSecret string
+	client    *http.Client
+}
+
+type httpSendResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func NewHttpSender(endpoint, appKey, appSecret string, timeout int64) *HttpSender {
+	return &HttpSender{
+		endpoint:  endpoint,
+		appKey:    appKey,
+		appSecret: appSecret,
+		client:    &http.Client{Timeout: time.Duration(timeout) * time.Millisecond},
+	}
+}
+
+func (s *HttpSender) Send(ctx context.Context, msg *Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(s.appKey) > 0 {
+		req.SetBasicAuth(s.appKey, s.appSecret)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("sms http status: %d", resp.StatusCode)
+	}
+	var ret httpSendResponse
+	if err = json.NewDecoder(resp.Body).Decode(&ret); err != nil {
+		return err
+	}
+	if ret.Code != 0 {
+		return fmt.Errorf("sms send failed, code: %d message: %s", ret.Code, ret.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	ProviderFile = "file"
+	ProviderHttp = "http"
+)
+
+type (
+	// Sender 短信发送接口，不同的短信服务商各自实现
+	Sender interface {
+		Send(ctx context.Context, msg *Message) error
+	}
+
+	Message struct {
+		Mobile     string            `json:"mobile"`
+		TemplateId string            `json:"template_id"`
+		Params     map[string]string `json:"params"`
+	}
+
+	FileConf struct {
+		Path string `json:",optional"` // 为空时输出到标准输出
+	}
+
+	HttpConf struct {
+		Endpoint  string `json:",optional"`
+		AppKey    string `json:",optional"`
+		AppSecret string `json:",optional"`
+		Timeout   int64  `json:",default=3000"` // 毫秒
+	}
+
+	Config struct {
+		Provider string   `json:",default=file,options=file|http"`
+		File     FileConf `json:",optional"`
+		Http     HttpConf `json:",optional"`
+	}
+)
+
+func NewSender(c Config) (Sender, error) {
+	switch c.Provider {
+	case ProviderFile:
+		return NewFileSender(c.File.Path), nil
+	case ProviderHttp:
+		if len(c.Http.Endpoint) == 0 {
+			return nil, fmt.Errorf("sms http endpoint is empty")
+		}
+		return NewHttpSender(c.Http.Endpoint, c.Http.AppKey, c.Http.AppSecret, c.Http.Timeout), nil
+	}
+
+	return nil, fmt.Errorf("unknown sms provider: %s", c.Provider)
+}
+
+func MustNewSender(c Config) Sender {
+	sender, err := NewSender(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return sender
+}
@@ -0,0 +1,66 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms.log")
+	sender, err := NewSender(Config{Provider: ProviderFile, File: FileConf{Path: path}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = sender.Send(context.Background(), &Message{
+		Mobile:     "13800138000",
+		TemplateId: "verification",
+		Params:     map[string]string{"code": "123456"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"code":"123456"`) {
+		t.Fatalf("unexpected sms log: %s", data)
+	}
+}
+
+func TestHttpSender(t *testing.T) {
+	var got Message
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		if got.Mobile == "13800000000" {
+			w.Write([]byte(`{"code":1,"message":"blacklisted"}`))
+			return
+		}
+		w.Write([]byte(`{"code":0}`))
+	}))
+	defer srv.Close()
+
+	sender := NewHttpSender(srv.URL, "key", "secret", 1000)
+	err := sender.Send(context.Background(), &Message{Mobile: "13800138000", TemplateId: "verification"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Mobile != "13800138000" || got.TemplateId != "verification" {
+		t.Fatalf("unexpected request: %+v", got)
+	}
+
+	err = sender.Send(context.Background(), &Message{Mobile: "13800000000", TemplateId: "verification"})
+	if err == nil {
+		t.Fatal("expected error for rejected sms")
+	}
+}
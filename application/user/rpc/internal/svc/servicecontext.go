@@ -2,20 +2,44 @@ package svc
 
 import (
 	"myBeyond/application/user/rpc/internal/config"
+	"myBeyond/application/user/rpc/internal/mail"
 	"myBeyond/application/user/rpc/internal/model"
+	"myBeyond/application/user/rpc/internal/sms"
+	"myBeyond/pkg/encrypt"
+	"myBeyond/pkg/limit"
+	"myBeyond/pkg/sensitive"
 
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 type ServiceContext struct {
-	Config    config.Config
-	UserModel model.UserModel
+	Config            config.Config
+	UserModel         model.UserModel
+	IdentityModel     model.UserIdentityModel
+	DeactivationModel model.UserDeactivationModel
+	SmsSender         sms.Sender
+	Mailer            mail.Mailer
+	MobileCipher      *encrypt.Cipher
+	Sensitive         *sensitive.Dict
+	BizRedis          *redis.Redis
+	PasswordFailLimit *limit.FailLimit
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewMysql(c.DataSource)
+	rds := redis.New(c.BizRedis.Host, redis.WithPass(c.BizRedis.Pass))
+	pl := c.PasswordFailLimit
 	return &ServiceContext{
-		Config:    c,
-		UserModel: model.NewUserModel(conn, c.CacheRedis),
+		Config:            c,
+		UserModel:         model.NewUserModel(conn, c.CacheRedis),
+		IdentityModel:     model.NewUserIdentityModel(conn, c.CacheRedis),
+		DeactivationModel: model.NewUserDeactivationModel(conn, c.CacheRedis),
+		SmsSender:         sms.MustNewSender(c.Sms),
+		Mailer:            mail.MustNewMailer(c.Mail),
+		MobileCipher:      encrypt.MustNewCipher(c.MobileCipher),
+		Sensitive:         sensitive.MustNewDict(c.Sensitive),
+		BizRedis:          rds,
+		PasswordFailLimit: limit.NewFailLimit(pl.Window, pl.Quota, pl.Lock, rds, "biz#login#password#"),
 	}
 }
@@ -25,8 +25,20 @@ const _ = grpc.SupportPackageIsVersion7
 type UserClient interface {
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	FindById(ctx context.Context, in *FindByIdRequest, opts ...grpc.CallOption) (*FindByIdResponse, error)
+	FindByIds(ctx context.Context, in *FindByIdsRequest, opts ...grpc.CallOption) (*FindByIdsResponse, error)
 	FindByMobile(ctx context.Context, in *FindByMobileRequest, opts ...grpc.CallOption) (*FindByMobileResponse, error)
 	SendSms(ctx context.Context, in *SendSmsRequest, opts ...grpc.CallOption) (*SendSmsResponse, error)
+	LoginByPassword(ctx context.Context, in *LoginByPasswordRequest, opts ...grpc.CallOption) (*LoginByPasswordResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	ChangeMobile(ctx context.Context, in *ChangeMobileRequest, opts ...grpc.CallOption) (*ChangeMobileResponse, error)
+	FindByIdentity(ctx context.Context, in *FindByIdentityRequest, opts ...grpc.CallOption) (*FindByIdentityResponse, error)
+	BindIdentity(ctx context.Context, in *BindIdentityRequest, opts ...grpc.CallOption) (*BindIdentityResponse, error)
+	DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error)
+	CancelDeactivation(ctx context.Context, in *CancelDeactivationRequest, opts ...grpc.CallOption) (*CancelDeactivationResponse, error)
+	FindByEmail(ctx context.Context, in *FindByEmailRequest, opts ...grpc.CallOption) (*FindByEmailResponse, error)
+	BindEmail(ctx context.Context, in *BindEmailRequest, opts ...grpc.CallOption) (*BindEmailResponse, error)
+	SendEmail(ctx context.Context, in *SendEmailRequest, opts ...grpc.CallOption) (*SendEmailResponse, error)
+	NotifyUser(ctx context.Context, in *NotifyUserRequest, opts ...grpc.CallOption) (*NotifyUserResponse, error)
 }
 
 type userClient struct {
@@ -55,6 +67,15 @@ func (c *userClient) FindById(ctx context.Context, in *FindByIdRequest, opts ...
 	return out, nil
 }
 
+func (c *userClient) FindByIds(ctx context.Context, in *FindByIdsRequest, opts ...grpc.CallOption) (*FindByIdsResponse, error) {
+	out := new(FindByIdsResponse)
+	err := c.cc.Invoke(ctx, "/service.User/FindByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) FindByMobile(ctx context.Context, in *FindByMobileRequest, opts ...grpc.CallOption) (*FindByMobileResponse, error) {
 	out := new(FindByMobileResponse)
 	err := c.cc.Invoke(ctx, "/service.User/FindByMobile", in, out, opts...)
@@ -73,14 +94,125 @@ func (c *userClient) SendSms(ctx context.Context, in *SendSmsRequest, opts ...gr
 	return out, nil
 }
 
+func (c *userClient) LoginByPassword(ctx context.Context, in *LoginByPasswordRequest, opts ...grpc.CallOption) (*LoginByPasswordResponse, error) {
+	out := new(LoginByPasswordResponse)
+	err := c.cc.Invoke(ctx, "/service.User/LoginByPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, "/service.User/UpdateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ChangeMobile(ctx context.Context, in *ChangeMobileRequest, opts ...grpc.CallOption) (*ChangeMobileResponse, error) {
+	out := new(ChangeMobileResponse)
+	err := c.cc.Invoke(ctx, "/service.User/ChangeMobile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) FindByIdentity(ctx context.Context, in *FindByIdentityRequest, opts ...grpc.CallOption) (*FindByIdentityResponse, error) {
+	out := new(FindByIdentityResponse)
+	err := c.cc.Invoke(ctx, "/service.User/FindByIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) BindIdentity(ctx context.Context, in *BindIdentityRequest, opts ...grpc.CallOption) (*BindIdentityResponse, error) {
+	out := new(BindIdentityResponse)
+	err := c.cc.Invoke(ctx, "/service.User/BindIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error) {
+	out := new(DeactivateAccountResponse)
+	err := c.cc.Invoke(ctx, "/service.User/DeactivateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CancelDeactivation(ctx context.Context, in *CancelDeactivationRequest, opts ...grpc.CallOption) (*CancelDeactivationResponse, error) {
+	out := new(CancelDeactivationResponse)
+	err := c.cc.Invoke(ctx, "/service.User/CancelDeactivation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) FindByEmail(ctx context.Context, in *FindByEmailRequest, opts ...grpc.CallOption) (*FindByEmailResponse, error) {
+	out := new(FindByEmailResponse)
+	err := c.cc.Invoke(ctx, "/service.User/FindByEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) BindEmail(ctx context.Context, in *BindEmailRequest, opts ...grpc.CallOption) (*BindEmailResponse, error) {
+	out := new(BindEmailResponse)
+	err := c.cc.Invoke(ctx, "/service.User/BindEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SendEmail(ctx context.Context, in *SendEmailRequest, opts ...grpc.CallOption) (*SendEmailResponse, error) {
+	out := new(SendEmailResponse)
+	err := c.cc.Invoke(ctx, "/service.User/SendEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) NotifyUser(ctx context.Context, in *NotifyUserRequest, opts ...grpc.CallOption) (*NotifyUserResponse, error) {
+	out := new(NotifyUserResponse)
+	err := c.cc.Invoke(ctx, "/service.User/NotifyUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility
 type UserServer interface {
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	FindById(context.Context, *FindByIdRequest) (*FindByIdResponse, error)
+	FindByIds(context.Context, *FindByIdsRequest) (*FindByIdsResponse, error)
 	FindByMobile(context.Context, *FindByMobileRequest) (*FindByMobileResponse, error)
 	SendSms(context.Context, *SendSmsRequest) (*SendSmsResponse, error)
+	LoginByPassword(context.Context, *LoginByPasswordRequest) (*LoginByPasswordResponse, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	ChangeMobile(context.Context, *ChangeMobileRequest) (*ChangeMobileResponse, error)
+	FindByIdentity(context.Context, *FindByIdentityRequest) (*FindByIdentityResponse, error)
+	BindIdentity(context.Context, *BindIdentityRequest) (*BindIdentityResponse, error)
+	DeactivateAccount(context.Context, *DeactivateAccountRequest) (*DeactivateAccountResponse, error)
+	CancelDeactivation(context.Context, *CancelDeactivationRequest) (*CancelDeactivationResponse, error)
+	FindByEmail(context.Context, *FindByEmailRequest) (*FindByEmailResponse, error)
+	BindEmail(context.Context, *BindEmailRequest) (*BindEmailResponse, error)
+	SendEmail(context.Context, *SendEmailRequest) (*SendEmailResponse, error)
+	NotifyUser(context.Context, *NotifyUserRequest) (*NotifyUserResponse, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -94,12 +226,48 @@ func (UnimplementedUserServer) Register(context.Context, *RegisterRequest) (*Reg
 func (UnimplementedUserServer) FindById(context.Context, *FindByIdRequest) (*FindByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindById not implemented")
 }
+func (UnimplementedUserServer) FindByIds(context.Context, *FindByIdsRequest) (*FindByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByIds not implemented")
+}
 func (UnimplementedUserServer) FindByMobile(context.Context, *FindByMobileRequest) (*FindByMobileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindByMobile not implemented")
 }
 func (UnimplementedUserServer) SendSms(context.Context, *SendSmsRequest) (*SendSmsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendSms not implemented")
 }
+func (UnimplementedUserServer) LoginByPassword(context.Context, *LoginByPasswordRequest) (*LoginByPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginByPassword not implemented")
+}
+func (UnimplementedUserServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedUserServer) ChangeMobile(context.Context, *ChangeMobileRequest) (*ChangeMobileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMobile not implemented")
+}
+func (UnimplementedUserServer) FindByIdentity(context.Context, *FindByIdentityRequest) (*FindByIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByIdentity not implemented")
+}
+func (UnimplementedUserServer) BindIdentity(context.Context, *BindIdentityRequest) (*BindIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindIdentity not implemented")
+}
+func (UnimplementedUserServer) DeactivateAccount(context.Context, *DeactivateAccountRequest) (*DeactivateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateAccount not implemented")
+}
+func (UnimplementedUserServer) CancelDeactivation(context.Context, *CancelDeactivationRequest) (*CancelDeactivationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeactivation not implemented")
+}
+func (UnimplementedUserServer) FindByEmail(context.Context, *FindByEmailRequest) (*FindByEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByEmail not implemented")
+}
+func (UnimplementedUserServer) BindEmail(context.Context, *BindEmailRequest) (*BindEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindEmail not implemented")
+}
+func (UnimplementedUserServer) SendEmail(context.Context, *SendEmailRequest) (*SendEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmail not implemented")
+}
+func (UnimplementedUserServer) NotifyUser(context.Context, *NotifyUserRequest) (*NotifyUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyUser not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -149,6 +317,24 @@ func _User_FindById_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_FindByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).FindByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/FindByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).FindByIds(ctx, req.(*FindByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_FindByMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindByMobileRequest)
 	if err := dec(in); err != nil {
@@ -185,6 +371,204 @@ func _User_SendSms_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_LoginByPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LoginByPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/LoginByPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LoginByPassword(ctx, req.(*LoginByPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ChangeMobile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMobileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ChangeMobile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/ChangeMobile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ChangeMobile(ctx, req.(*ChangeMobileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_FindByIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).FindByIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/FindByIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).FindByIdentity(ctx, req.(*FindByIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_BindIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BindIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/BindIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BindIdentity(ctx, req.(*BindIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeactivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeactivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/DeactivateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeactivateAccount(ctx, req.(*DeactivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CancelDeactivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeactivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CancelDeactivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/CancelDeactivation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CancelDeactivation(ctx, req.(*CancelDeactivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_FindByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).FindByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/FindByEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).FindByEmail(ctx, req.(*FindByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_BindEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BindEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/BindEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BindEmail(ctx, req.(*BindEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SendEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SendEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/SendEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SendEmail(ctx, req.(*SendEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_NotifyUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).NotifyUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.User/NotifyUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).NotifyUser(ctx, req.(*NotifyUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -200,6 +584,10 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindById",
 			Handler:    _User_FindById_Handler,
 		},
+		{
+			MethodName: "FindByIds",
+			Handler:    _User_FindByIds_Handler,
+		},
 		{
 			MethodName: "FindByMobile",
 			Handler:    _User_FindByMobile_Handler,
@@ -208,6 +596,50 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendSms",
 			Handler:    _User_SendSms_Handler,
 		},
+		{
+			MethodName: "LoginByPassword",
+			Handler:    _User_LoginByPassword_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _User_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "ChangeMobile",
+			Handler:    _User_ChangeMobile_Handler,
+		},
+		{
+			MethodName: "FindByIdentity",
+			Handler:    _User_FindByIdentity_Handler,
+		},
+		{
+			MethodName: "BindIdentity",
+			Handler:    _User_BindIdentity_Handler,
+		},
+		{
+			MethodName: "DeactivateAccount",
+			Handler:    _User_DeactivateAccount_Handler,
+		},
+		{
+			MethodName: "CancelDeactivation",
+			Handler:    _User_CancelDeactivation_Handler,
+		},
+		{
+			MethodName: "FindByEmail",
+			Handler:    _User_FindByEmail_Handler,
+		},
+		{
+			MethodName: "BindEmail",
+			Handler:    _User_BindEmail_Handler,
+		},
+		{
+			MethodName: "SendEmail",
+			Handler:    _User_SendEmail_Handler,
+		},
+		{
+			MethodName: "NotifyUser",
+			Handler:    _User_NotifyUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -13,20 +13,58 @@ import (
 )
 
 type (
-	FindByIdRequest      = service.FindByIdRequest
-	FindByIdResponse     = service.FindByIdResponse
-	FindByMobileRequest  = service.FindByMobileRequest
-	FindByMobileResponse = service.FindByMobileResponse
-	RegisterRequest      = service.RegisterRequest
-	RegisterResponse     = service.RegisterResponse
-	SendSmsRequest       = service.SendSmsRequest
-	SendSmsResponse      = service.SendSmsResponse
+	BindEmailRequest           = service.BindEmailRequest
+	BindEmailResponse          = service.BindEmailResponse
+	BindIdentityRequest        = service.BindIdentityRequest
+	BindIdentityResponse       = service.BindIdentityResponse
+	CancelDeactivationRequest  = service.CancelDeactivationRequest
+	CancelDeactivationResponse = service.CancelDeactivationResponse
+	ChangeMobileRequest        = service.ChangeMobileRequest
+	ChangeMobileResponse       = service.ChangeMobileResponse
+	DeactivateAccountRequest   = service.DeactivateAccountRequest
+	DeactivateAccountResponse  = service.DeactivateAccountResponse
+	FindByEmailRequest         = service.FindByEmailRequest
+	FindByEmailResponse        = service.FindByEmailResponse
+	FindByIdRequest            = service.FindByIdRequest
+	FindByIdResponse           = service.FindByIdResponse
+	FindByIdentityRequest      = service.FindByIdentityRequest
+	FindByIdentityResponse     = service.FindByIdentityResponse
+	FindByIdsRequest           = service.FindByIdsRequest
+	FindByIdsResponse          = service.FindByIdsResponse
+	FindByMobileRequest        = service.FindByMobileRequest
+	FindByMobileResponse       = service.FindByMobileResponse
+	LoginByPasswordRequest     = service.LoginByPasswordRequest
+	LoginByPasswordResponse    = service.LoginByPasswordResponse
+	NotifyUserRequest          = service.NotifyUserRequest
+	NotifyUserResponse         = service.NotifyUserResponse
+	RegisterIdentity           = service.RegisterIdentity
+	RegisterRequest            = service.RegisterRequest
+	RegisterResponse           = service.RegisterResponse
+	SendEmailRequest           = service.SendEmailRequest
+	SendEmailResponse          = service.SendEmailResponse
+	SendSmsRequest             = service.SendSmsRequest
+	SendSmsResponse            = service.SendSmsResponse
+	UpdateProfileRequest       = service.UpdateProfileRequest
+	UpdateProfileResponse      = service.UpdateProfileResponse
+	UserItem                   = service.UserItem
 
 	User interface {
 		Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 		FindById(ctx context.Context, in *FindByIdRequest, opts ...grpc.CallOption) (*FindByIdResponse, error)
+		FindByIds(ctx context.Context, in *FindByIdsRequest, opts ...grpc.CallOption) (*FindByIdsResponse, error)
 		FindByMobile(ctx context.Context, in *FindByMobileRequest, opts ...grpc.CallOption) (*FindByMobileResponse, error)
 		SendSms(ctx context.Context, in *SendSmsRequest, opts ...grpc.CallOption) (*SendSmsResponse, error)
+		LoginByPassword(ctx context.Context, in *LoginByPasswordRequest, opts ...grpc.CallOption) (*LoginByPasswordResponse, error)
+		UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+		ChangeMobile(ctx context.Context, in *ChangeMobileRequest, opts ...grpc.CallOption) (*ChangeMobileResponse, error)
+		FindByIdentity(ctx context.Context, in *FindByIdentityRequest, opts ...grpc.CallOption) (*FindByIdentityResponse, error)
+		BindIdentity(ctx context.Context, in *BindIdentityRequest, opts ...grpc.CallOption) (*BindIdentityResponse, error)
+		DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error)
+		CancelDeactivation(ctx context.Context, in *CancelDeactivationRequest, opts ...grpc.CallOption) (*CancelDeactivationResponse, error)
+		FindByEmail(ctx context.Context, in *FindByEmailRequest, opts ...grpc.CallOption) (*FindByEmailResponse, error)
+		BindEmail(ctx context.Context, in *BindEmailRequest, opts ...grpc.CallOption) (*BindEmailResponse, error)
+		SendEmail(ctx context.Context, in *SendEmailRequest, opts ...grpc.CallOption) (*SendEmailResponse, error)
+		NotifyUser(ctx context.Context, in *NotifyUserRequest, opts ...grpc.CallOption) (*NotifyUserResponse, error)
 	}
 
 	defaultUser struct {
@@ -50,6 +88,11 @@ func (m *defaultUser) FindById(ctx context.Context, in *FindByIdRequest, opts ..
 	return client.FindById(ctx, in, opts...)
 }
 
+func (m *defaultUser) FindByIds(ctx context.Context, in *FindByIdsRequest, opts ...grpc.CallOption) (*FindByIdsResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.FindByIds(ctx, in, opts...)
+}
+
 func (m *defaultUser) FindByMobile(ctx context.Context, in *FindByMobileRequest, opts ...grpc.CallOption) (*FindByMobileResponse, error) {
 	client := service.NewUserClient(m.cli.Conn())
 	return client.FindByMobile(ctx, in, opts...)
@@ -59,3 +102,58 @@ func (m *defaultUser) SendSms(ctx context.Context, in *SendSmsRequest, opts ...g
 	client := service.NewUserClient(m.cli.Conn())
 	return client.SendSms(ctx, in, opts...)
 }
+
+func (m *defaultUser) LoginByPassword(ctx context.Context, in *LoginByPasswordRequest, opts ...grpc.CallOption) (*LoginByPasswordResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.LoginByPassword(ctx, in, opts...)
+}
+
+func (m *defaultUser) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.UpdateProfile(ctx, in, opts...)
+}
+
+func (m *defaultUser) ChangeMobile(ctx context.Context, in *ChangeMobileRequest, opts ...grpc.CallOption) (*ChangeMobileResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.ChangeMobile(ctx, in, opts...)
+}
+
+func (m *defaultUser) FindByIdentity(ctx context.Context, in *FindByIdentityRequest, opts ...grpc.CallOption) (*FindByIdentityResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.FindByIdentity(ctx, in, opts...)
+}
+
+func (m *defaultUser) BindIdentity(ctx context.Context, in *BindIdentityRequest, opts ...grpc.CallOption) (*BindIdentityResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.BindIdentity(ctx, in, opts...)
+}
+
+func (m *defaultUser) DeactivateAccount(ctx context.Context, in *DeactivateAccountRequest, opts ...grpc.CallOption) (*DeactivateAccountResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.DeactivateAccount(ctx, in, opts...)
+}
+
+func (m *defaultUser) CancelDeactivation(ctx context.Context, in *CancelDeactivationRequest, opts ...grpc.CallOption) (*CancelDeactivationResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.CancelDeactivation(ctx, in, opts...)
+}
+
+func (m *defaultUser) FindByEmail(ctx context.Context, in *FindByEmailRequest, opts ...grpc.CallOption) (*FindByEmailResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.FindByEmail(ctx, in, opts...)
+}
+
+func (m *defaultUser) BindEmail(ctx context.Context, in *BindEmailRequest, opts ...grpc.CallOption) (*BindEmailResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.BindEmail(ctx, in, opts...)
+}
+
+func (m *defaultUser) SendEmail(ctx context.Context, in *SendEmailRequest, opts ...grpc.CallOption) (*SendEmailResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.SendEmail(ctx, in, opts...)
+}
+
+func (m *defaultUser) NotifyUser(ctx context.Context, in *NotifyUserRequest, opts ...grpc.CallOption) (*NotifyUserResponse, error) {
+	client := service.NewUserClient(m.cli.Conn())
+	return client.NotifyUser(ctx, in, opts...)
+}
@@ -0,0 +1,5 @@
+-- 已有数据库增加密码，旧用户密码为空，只能使用验证码登录
+use beyond_user;
+
+ALTER TABLE `user`
+  ADD COLUMN `password` varchar(128) NOT NULL DEFAULT '' COMMENT '密码' AFTER `mobile`;
@@ -0,0 +1,7 @@
+-- 已有数据库增加手机号盲索引，执行后运行 application/user/rpc/cmd/reencrypt 迁移旧数据
+use beyond_user;
+
+ALTER TABLE `user`
+  MODIFY COLUMN `mobile` varchar(128) DEFAULT NULL COMMENT '手机号密文',
+  ADD COLUMN `mobile_index` char(64) DEFAULT NULL COMMENT '手机号的HMAC盲索引' AFTER `mobile`,
+  ADD UNIQUE KEY `uk_mobile_index` (`mobile_index`);
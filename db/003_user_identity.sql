@@ -0,0 +1,16 @@
+-- 已有数据库增加第三方身份绑定
+use beyond_user;
+
+CREATE TABLE `user_identity` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `user_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '用户ID',
+  `provider` varchar(16) NOT NULL DEFAULT '' COMMENT '第三方平台，如wechat',
+  `open_id` varchar(64) NOT NULL DEFAULT '' COMMENT '平台下应用维度的用户标识',
+  `union_id` varchar(64) NOT NULL DEFAULT '' COMMENT '平台下开放平台维度的用户标识',
+  `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  KEY `ix_user_id` (`user_id`),
+  KEY `ix_union_id` (`provider`, `union_id`),
+  UNIQUE KEY `uk_provider_open_id` (`provider`, `open_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户第三方身份绑定表';
@@ -0,0 +1,17 @@
+-- 已有数据库增加账号注销，注销用户的手机号置为NULL，不占用唯一索引
+use beyond_user;
+
+ALTER TABLE `user`
+  MODIFY COLUMN `mobile` varchar(128) DEFAULT NULL COMMENT '手机号密文，已注销的用户为NULL';
+
+CREATE TABLE `user_deactivation` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `user_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '用户ID',
+  `status` tinyint(1) UNSIGNED NOT NULL DEFAULT '1' COMMENT '状态：1-冷静期，2-已取消，3-清理中，4-已注销',
+  `delete_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '冷静期结束时间',
+  `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_id` (`user_id`),
+  KEY `ix_status_delete_time` (`status`, `delete_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户注销表';
@@ -0,0 +1,7 @@
+-- 已有数据库增加邮箱，只有邮箱的用户mobile为NULL，唯一索引允许多个NULL
+use beyond_user;
+
+ALTER TABLE `user`
+  MODIFY COLUMN `mobile` varchar(128) DEFAULT NULL COMMENT '手机号密文，只用邮箱注册和已注销的用户为NULL',
+  ADD COLUMN `email` varchar(128) DEFAULT NULL COMMENT '邮箱，统一小写，未绑定时为NULL' AFTER `mobile_index`,
+  ADD UNIQUE KEY `uk_email` (`email`);
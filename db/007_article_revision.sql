@@ -0,0 +1,15 @@
+-- 已有数据库增加文章修改历史
+use beyond_article;
+
+CREATE TABLE `article_revision` (
+    `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `article_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '文章ID',
+    `title` varchar(255) NOT NULL DEFAULT '' COMMENT '修改前的标题',
+    `content` text COLLATE utf8_unicode_ci NOT NULL COMMENT '修改前的内容',
+    `cover` varchar(255) NOT NULL DEFAULT '' COMMENT '修改前的封面',
+    `description` varchar(255) NOT NULL DEFAULT '' COMMENT '修改前的描述',
+    `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+    PRIMARY KEY (`id`),
+    KEY `ix_article_id` (`article_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='文章修改历史表';
@@ -0,0 +1,6 @@
+-- 已有数据库增加草稿和定时发布，cmd/scheduler按status和publish_time查询到期的文章
+use beyond_article;
+
+ALTER TABLE `article`
+  MODIFY COLUMN `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '状态 0:待审核 1:审核不通过 2:可见 3:用户删除 4:草稿 5:定时发布',
+  ADD KEY `ix_status_publish_time` (`status`, `publish_time`);
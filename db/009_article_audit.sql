@@ -0,0 +1,15 @@
+-- 已有数据库增加文章审核记录
+use beyond_article;
+
+CREATE TABLE `article_audit` (
+    `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `article_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '文章ID',
+    `author_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '作者ID',
+    `operator_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '审核人ID',
+    `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '审核结果 1:审核不通过 2:可见',
+    `reason` varchar(255) NOT NULL DEFAULT '' COMMENT '审核不通过的原因',
+    `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+    PRIMARY KEY (`id`),
+    KEY `ix_article_id` (`article_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='文章审核记录表';
@@ -8,7 +8,7 @@ CREATE TABLE `article` (
     `cover` varchar(255) NOT NULL DEFAULT '' COMMENT '封面',
     `description` varchar(255) NOT NULL DEFAULT '' COMMENT '描述',
     `author_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '作者ID',
-    `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '状态 0:待审核 1:审核不通过 2:可见 3:用户删除',
+    `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '状态 0:待审核 1:审核不通过 2:可见 3:用户删除 4:草稿 5:定时发布',
     `comment_num` int(11) NOT NULL DEFAULT '0' COMMENT '评论数',
     `like_num` int(11) NOT NULL DEFAULT '0' COMMENT '点赞数',
     `collect_num` int(11) NOT NULL DEFAULT '0' COMMENT '收藏数',
@@ -20,9 +20,36 @@ CREATE TABLE `article` (
     `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
     PRIMARY KEY (`id`),
     KEY `ix_author_id` (`author_id`),
-    KEY `ix_update_time` (`update_time`)
+    KEY `ix_update_time` (`update_time`),
+    KEY `ix_status_publish_time` (`status`, `publish_time`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='文章表';
 
+CREATE TABLE `article_revision` (
+    `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `article_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '文章ID',
+    `title` varchar(255) NOT NULL DEFAULT '' COMMENT '修改前的标题',
+    `content` text COLLATE utf8_unicode_ci NOT NULL COMMENT '修改前的内容',
+    `cover` varchar(255) NOT NULL DEFAULT '' COMMENT '修改前的封面',
+    `description` varchar(255) NOT NULL DEFAULT '' COMMENT '修改前的描述',
+    `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+    PRIMARY KEY (`id`),
+    KEY `ix_article_id` (`article_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='文章修改历史表';
+
+CREATE TABLE `article_audit` (
+    `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `article_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '文章ID',
+    `author_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '作者ID',
+    `operator_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '审核人ID',
+    `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '审核结果 1:审核不通过 2:可见',
+    `reason` varchar(255) NOT NULL DEFAULT '' COMMENT '审核不通过的原因',
+    `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+    PRIMARY KEY (`id`),
+    KEY `ix_article_id` (`article_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='文章审核记录表';
+
 
 insert into article(title, content, author_id, like_num, publish_time) values ('文章测试3', '文章内容1', 1, 3, '2023-10-04 17:01:01');
 insert into article(title, content, author_id, like_num, publish_time) values ('文章测试4', '文章内容2', 1, 4, '2023-10-04 15:01:01');
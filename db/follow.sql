@@ -24,4 +24,16 @@ CREATE TABLE `follow_count` (
     PRIMARY KEY (`id`),
     UNIQUE KEY `uk_user_id` (`user_id`),
     KEY `ix_update_time` (`update_time`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '关注计数表';
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '关注计数表';
+CREATE TABLE `block` (
+    `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `user_id` bigint(20) UNSIGNED NOT NULL COMMENT '用户ID',
+    `blocked_user_id` bigint(20) UNSIGNED NOT NULL COMMENT '被拉黑用户ID',
+    `block_status` tinyint(1) UNSIGNED NOT NULL DEFAULT '1' COMMENT '拉黑状态：1-拉黑，2-取消拉黑',
+    `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_id_blocked_user_id` (`user_id`,`blocked_user_id`),
+    KEY `ix_blocked_user_id` (`blocked_user_id`),
+    KEY `ix_update_time` (`update_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT '拉黑表';
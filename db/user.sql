@@ -3,12 +3,43 @@ use beyond_user;
 
 CREATE TABLE `user` (
   `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
-  `username` varchar(32) NOT NULL DEFAULT '' COMMENT '用户名',
+  `username` varchar(32) NOT NULL DEFAULT '' COMMENT '用户名，只用于展示，允许重复',
   `avatar` varchar(256) NOT NULL DEFAULT '' COMMENT '头像',
-  `mobile` varchar(128) NOT NULL DEFAULT '' COMMENT '手机号',
+  `mobile` varchar(128) DEFAULT NULL COMMENT '手机号密文，只用邮箱注册和已注销的用户为NULL',
+  `mobile_index` char(64) DEFAULT NULL COMMENT '手机号的HMAC盲索引',
+  `email` varchar(128) DEFAULT NULL COMMENT '邮箱，统一小写，未绑定时为NULL',
+  `password` varchar(128) NOT NULL DEFAULT '' COMMENT '密码哈希，argon2id或旧版本的md5',
   `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
   PRIMARY KEY (`id`),
   KEY `ix_update_time` (`update_time`),
-  UNIQUE KEY `uk_mobile` (`mobile`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户表';
\ No newline at end of file
+  UNIQUE KEY `uk_mobile` (`mobile`),
+  UNIQUE KEY `uk_mobile_index` (`mobile_index`),
+  UNIQUE KEY `uk_email` (`email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户表';
+
+CREATE TABLE `user_identity` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `user_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '用户ID',
+  `provider` varchar(16) NOT NULL DEFAULT '' COMMENT '第三方平台，如wechat',
+  `open_id` varchar(64) NOT NULL DEFAULT '' COMMENT '平台下应用维度的用户标识',
+  `union_id` varchar(64) NOT NULL DEFAULT '' COMMENT '平台下开放平台维度的用户标识',
+  `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  KEY `ix_user_id` (`user_id`),
+  KEY `ix_union_id` (`provider`, `union_id`),
+  UNIQUE KEY `uk_provider_open_id` (`provider`, `open_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户第三方身份绑定表';
+
+CREATE TABLE `user_deactivation` (
+  `id` bigint(20) UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+  `user_id` bigint(20) UNSIGNED NOT NULL DEFAULT '0' COMMENT '用户ID',
+  `status` tinyint(1) UNSIGNED NOT NULL DEFAULT '1' COMMENT '状态：1-冷静期，2-已取消，3-清理中，4-已注销',
+  `delete_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '冷静期结束时间',
+  `create_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `update_time` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_id` (`user_id`),
+  KEY `ix_status_delete_time` (`status`, `delete_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='用户注销表';
@@ -0,0 +1,149 @@
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// 密文格式为 <kid>:<base64(nonce+ciphertext)>，没有kid前缀的是旧版本AES-ECB的密文
+	cipherKeyIdSep = ":"
+	// PlaceholderKey 配置文件中密钥的占位值，部署时必须替换为 openssl rand -base64 32 生成的密钥
+	PlaceholderKey = "CHANGE_ME"
+)
+
+var (
+	ErrInvalidCiphertext = errors.New("invalid ciphertext")
+	ErrPlaceholderKey    = errors.New("cipher key is still the placeholder, generate one with: openssl rand -base64 32")
+)
+
+type (
+	// CipherKey Key为base64编码的AES-256密钥
+	CipherKey struct {
+		Id  string
+		Key string
+	}
+
+	// CipherConf 加密使用CurrentKeyId对应的密钥，解密根据密文中的kid选择密钥
+	// IndexKey为盲索引的HMAC密钥，修改后需要重建所有索引，不参与轮换
+	CipherConf struct {
+		CurrentKeyId string
+		Keys         []CipherKey
+		IndexKey     string
+	}
+
+	// Cipher 使用AES-GCM加密敏感字段，并生成用于等值查询的HMAC盲索引
+	Cipher struct {
+		currentKeyId string
+		aeads        map[string]cipher.AEAD
+		indexKey     []byte
+	}
+)
+
+func NewCipher(c CipherConf) (*Cipher, error) {
+	if c.IndexKey == PlaceholderKey {
+		return nil, fmt.Errorf("index key: %w", ErrPlaceholderKey)
+	}
+	indexKey, err := base64.StdEncoding.DecodeString(c.IndexKey)
+	if err != nil || len(indexKey) < 32 {
+		return nil, errors.New("index key must be at least 32 bytes in base64")
+	}
+
+	ret := &Cipher{
+		currentKeyId: c.CurrentKeyId,
+		aeads:        make(map[string]cipher.AEAD, len(c.Keys)),
+		indexKey:     indexKey,
+	}
+	for _, k := range c.Keys {
+		if len(k.Id) == 0 || strings.Contains(k.Id, cipherKeyIdSep) {
+			return nil, fmt.Errorf("invalid key id %q", k.Id)
+		}
+		if k.Key == PlaceholderKey {
+			return nil, fmt.Errorf("key %q: %w", k.Id, ErrPlaceholderKey)
+		}
+		key, err := base64.StdEncoding.DecodeString(k.Key)
+		if err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("key %q must be 32 bytes in base64", k.Id)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		ret.aeads[k.Id] = aead
+	}
+	if _, ok := ret.aeads[c.CurrentKeyId]; !ok {
+		return nil, fmt.Errorf("current key %q not found", c.CurrentKeyId)
+	}
+
+	return ret, nil
+}
+
+func MustNewCipher(c CipherConf) *Cipher {
+	ret, err := NewCipher(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+// Encrypt 使用当前密钥加密，每次加密使用随机的nonce
+func (c *Cipher) Encrypt(plaintext string) (string, error) {
+	aead := c.aeads[c.currentKeyId]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(c.currentKeyId))
+
+	return c.currentKeyId + cipherKeyIdSep + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt 根据kid选择密钥解密，兼容旧版本AES-ECB的密文
+func (c *Cipher) Decrypt(ciphertext string) (string, error) {
+	keyId, data, ok := strings.Cut(ciphertext, cipherKeyIdSep)
+	if !ok {
+		return DecMobile(ciphertext)
+	}
+
+	aead, ok := c.aeads[keyId]
+	if !ok {
+		return "", fmt.Errorf("unknown key id %q", keyId)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(data)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+	nonce, sealed := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, []byte(keyId))
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	return string(plaintext), nil
+}
+
+// NeedReEncrypt 密文不是使用当前密钥加密的
+func (c *Cipher) NeedReEncrypt(ciphertext string) bool {
+	keyId, _, ok := strings.Cut(ciphertext, cipherKeyIdSep)
+	return !ok || keyId != c.currentKeyId
+}
+
+// BlindIndex 明文的HMAC-SHA256，相同的明文得到相同的索引，用于等值查询
+func (c *Cipher) BlindIndex(plaintext string) string {
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(plaintext))
+	return hex.EncodeToString(mac.Sum(nil))
+}
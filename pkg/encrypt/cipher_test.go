@@ -0,0 +1,126 @@
+package encrypt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+var testCipherConf = CipherConf{
+	CurrentKeyId: "v2",
+	Keys: []CipherKey{
+		{Id: "v1", Key: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="},
+		{Id: "v2", Key: "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="},
+	},
+	IndexKey: "aW5kZXgta2V5LWluZGV4LWtleS1pbmRleC1rZXktMzI=",
+}
+
+func TestCipherEncrypt(t *testing.T) {
+	c := MustNewCipher(testCipherConf)
+	mobile := "13800138000"
+
+	first, err := c.Encrypt(mobile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := c.Encrypt(mobile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 相同的明文每次加密结果不同
+	if first == second {
+		t.Fatal("expected different ciphertexts for the same plaintext")
+	}
+	if !strings.HasPrefix(first, "v2:") || c.NeedReEncrypt(first) {
+		t.Fatalf("unexpected ciphertext: %s", first)
+	}
+
+	plaintext, err := c.Decrypt(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plaintext != mobile {
+		t.Fatalf("expected %s, but got %s", mobile, plaintext)
+	}
+
+	// 篡改密文后无法解密
+	tampered := first[:len(first)-2] + "AA"
+	if _, err = c.Decrypt(tampered); err == nil {
+		t.Fatal("expected error for tampered ciphertext")
+	}
+}
+
+func TestCipherKeyRotation(t *testing.T) {
+	conf := testCipherConf
+	conf.CurrentKeyId = "v1"
+	old, err := MustNewCipher(conf).Encrypt("13800138000")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := MustNewCipher(testCipherConf)
+	plaintext, err := c.Decrypt(old)
+	if err != nil || plaintext != "13800138000" {
+		t.Fatalf("expected 13800138000, but got %s, err: %v", plaintext, err)
+	}
+	if !c.NeedReEncrypt(old) {
+		t.Fatal("expected ciphertext of old key to need re-encryption")
+	}
+}
+
+func TestCipherLegacy(t *testing.T) {
+	c := MustNewCipher(testCipherConf)
+	legacy, err := EncMobile("13800138000")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext, err := c.Decrypt(legacy)
+	if err != nil || plaintext != "13800138000" {
+		t.Fatalf("expected 13800138000, but got %s, err: %v", plaintext, err)
+	}
+	if !c.NeedReEncrypt(legacy) {
+		t.Fatal("expected legacy ciphertext to need re-encryption")
+	}
+}
+
+func TestCipherBlindIndex(t *testing.T) {
+	c := MustNewCipher(testCipherConf)
+	if c.BlindIndex("13800138000") != c.BlindIndex("13800138000") {
+		t.Fatal("expected the same index for the same plaintext")
+	}
+	if c.BlindIndex("13800138000") == c.BlindIndex("13800138001") {
+		t.Fatal("expected different indexes for different plaintexts")
+	}
+	if len(c.BlindIndex("13800138000")) != 64 {
+		t.Fatalf("unexpected index length: %d", len(c.BlindIndex("13800138000")))
+	}
+}
+
+func TestNewCipherInvalidConf(t *testing.T) {
+	conf := testCipherConf
+	conf.CurrentKeyId = "v3"
+	if _, err := NewCipher(conf); err == nil {
+		t.Fatal("expected error for missing current key")
+	}
+
+	conf = testCipherConf
+	conf.Keys = []CipherKey{{Id: "v:2", Key: testCipherConf.Keys[1].Key}}
+	if _, err := NewCipher(conf); err == nil {
+		t.Fatal("expected error for invalid key id")
+	}
+}
+
+func TestNewCipherPlaceholder(t *testing.T) {
+	conf := testCipherConf
+	conf.Keys = []CipherKey{{Id: "v2", Key: PlaceholderKey}}
+	if _, err := NewCipher(conf); !errors.Is(err, ErrPlaceholderKey) {
+		t.Fatalf("expected ErrPlaceholderKey for placeholder key, got %v", err)
+	}
+
+	conf = testCipherConf
+	conf.IndexKey = PlaceholderKey
+	if _, err := NewCipher(conf); !errors.Is(err, ErrPlaceholderKey) {
+		t.Fatalf("expected ErrPlaceholderKey for placeholder index key, got %v", err)
+	}
+}
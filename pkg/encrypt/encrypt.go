@@ -14,6 +14,9 @@ const (
 	mobileAesKey        = "5A2E746B08D846502F37A6E2D85D583B"
 )
 
+// EncPassword 旧版本的密码哈希，只用于校验已经保存的旧密码
+//
+// Deprecated: 使用HashPassword
 func EncPassword(password string) string {
 	return Md5Sum([]byte(strings.TrimSpace(password + passwordEncryptSeed)))
 }
@@ -0,0 +1,96 @@
+package encrypt
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2id的默认参数，参考OWASP的推荐值
+const (
+	argon2Algorithm = "argon2id"
+	argon2Memory    = 19 * 1024 // KiB
+	argon2Time      = 2
+	argon2Threads   = 1
+	argon2SaltLen   = 16
+	argon2KeyLen    = 32
+)
+
+var ErrInvalidPasswordHash = errors.New("invalid password hash")
+
+type argon2Params struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// HashPassword 使用argon2id计算密码的哈希，结果中包含算法、参数和盐
+// 格式为 $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2Algorithm, argon2.Version,
+		argon2Memory, argon2Time, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// VerifyPassword 校验密码，同时兼容EncPassword生成的旧哈希
+// needRehash为true表示密码正确但哈希是旧格式或者参数已经过时，应该重新计算后保存
+func VerifyPassword(password, hashed string) (match bool, needRehash bool) {
+	if !strings.HasPrefix(hashed, "$") {
+		// 旧版本的md5哈希
+		match = subtle.ConstantTimeCompare([]byte(EncPassword(password)), []byte(hashed)) == 1
+		return match, match
+	}
+
+	params, err := parseArgon2Hash(hashed)
+	if err != nil {
+		return false, false
+	}
+	key := argon2.IDKey([]byte(password), params.salt, params.time, params.memory, params.threads, uint32(len(params.key)))
+	if subtle.ConstantTimeCompare(key, params.key) != 1 {
+		return false, false
+	}
+
+	needRehash = params.memory != argon2Memory || params.time != argon2Time ||
+		params.threads != argon2Threads || len(params.key) != argon2KeyLen
+	return true, needRehash
+}
+
+func parseArgon2Hash(hashed string) (*argon2Params, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 6 || parts[1] != argon2Algorithm {
+		return nil, ErrInvalidPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, ErrInvalidPasswordHash
+	}
+
+	var params argon2Params
+	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads)
+	if err != nil || params.time == 0 || params.threads == 0 {
+		return nil, ErrInvalidPasswordHash
+	}
+	if params.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, ErrInvalidPasswordHash
+	}
+	if params.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(params.key) == 0 {
+		return nil, ErrInvalidPasswordHash
+	}
+
+	return &params, nil
+}
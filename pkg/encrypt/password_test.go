@@ -0,0 +1,76 @@
+package encrypt
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+)
+
+func TestHashPassword(t *testing.T) {
+	hashed, err := HashPassword("beyond123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hashed, "$argon2id$v=19$m=19456,t=2,p=1$") {
+		t.Fatalf("unexpected hash format: %s", hashed)
+	}
+
+	// 相同的密码每次使用不同的盐
+	another, err := HashPassword("beyond123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hashed == another {
+		t.Fatal("expected different hashes for the same password")
+	}
+
+	match, needRehash := VerifyPassword("beyond123", hashed)
+	if !match || needRehash {
+		t.Fatalf("expected match without rehash, but got match=%v needRehash=%v", match, needRehash)
+	}
+	if match, _ = VerifyPassword("beyond124", hashed); match {
+		t.Fatal("expected wrong password not to match")
+	}
+}
+
+func TestVerifyLegacyPassword(t *testing.T) {
+	legacy := EncPassword("beyond123")
+
+	match, needRehash := VerifyPassword("beyond123", legacy)
+	if !match || !needRehash {
+		t.Fatalf("expected match with rehash, but got match=%v needRehash=%v", match, needRehash)
+	}
+	if match, needRehash = VerifyPassword("beyond124", legacy); match || needRehash {
+		t.Fatalf("expected no match, but got match=%v needRehash=%v", match, needRehash)
+	}
+}
+
+func TestVerifyPasswordOutdatedParams(t *testing.T) {
+	// 使用旧参数m=8192,t=1生成的哈希，校验通过后需要重新计算
+	salt := []byte("saltsaltsaltsalt")
+	key := argon2.IDKey([]byte("beyond123"), salt, 1, 8192, 1, argon2KeyLen)
+	hashed := fmt.Sprintf("$argon2id$v=19$m=8192,t=1,p=1$%s$%s",
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+
+	match, needRehash := VerifyPassword("beyond123", hashed)
+	if !match || !needRehash {
+		t.Fatalf("expected match with rehash, but got match=%v needRehash=%v", match, needRehash)
+	}
+}
+
+func TestVerifyPasswordInvalidHash(t *testing.T) {
+	for _, hashed := range []string{
+		"",
+		"$argon2id$v=19$m=19456,t=2,p=1$salt",
+		"$argon2i$v=19$m=19456,t=2,p=1$c2FsdA$a2V5",
+		"$argon2id$v=16$m=19456,t=2,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=19456,t=0,p=1$c2FsdA$a2V5",
+	} {
+		if match, _ := VerifyPassword("beyond123", hashed); match {
+			t.Fatalf("expected invalid hash %q not to match", hashed)
+		}
+	}
+}
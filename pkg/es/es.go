@@ -21,6 +21,8 @@ type (
 		Username   string
 		Password   string
 		MaxRetries int
+		// 为空时使用http.DefaultTransport，测试时可以替换为回放录制响应的transport
+		Transport http.RoundTripper
 	}
 
 	Es struct {
@@ -28,7 +30,9 @@ type (
 	}
 
 	// esTransport is a transport for elasticsearch client
-	esTransport struct{}
+	esTransport struct {
+		next http.RoundTripper
+	}
 )
 
 func (t *esTransport) RoundTrip(req *http.Request) (resp *http.Response, err error) {
@@ -56,7 +60,7 @@ func (t *esTransport) RoundTrip(req *http.Request) (resp *http.Response, err err
 	req = req.WithContext(ctx)
 	propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
 
-	resp, err = http.DefaultTransport.RoundTrip(req)
+	resp, err = t.next.RoundTrip(req)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -71,12 +75,16 @@ func (t *esTransport) RoundTrip(req *http.Request) (resp *http.Response, err err
 }
 
 func NewEs(conf *Config) (*Es, error) {
+	next := conf.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
 	c := es8.Config{
 		Addresses:  conf.Addresses,
 		Username:   conf.Username,
 		Password:   conf.Password,
 		MaxRetries: conf.MaxRetries,
-		Transport:  &esTransport{},
+		Transport:  &esTransport{next: next},
 	}
 
 	client, err := es8.NewClient(c)
@@ -0,0 +1,68 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+)
+
+// CropSquare 以中心为基准裁剪出最大的正方形区域
+func CropSquare(img image.Image) image.Rectangle {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > h {
+		x0 := b.Min.X + (w-h)/2
+		return image.Rect(x0, b.Min.Y, x0+h, b.Max.Y)
+	}
+	y0 := b.Min.Y + (h-w)/2
+	return image.Rect(b.Min.X, y0, b.Max.X, y0+w)
+}
+
+// ResizeSquare 裁剪出中心正方形并缩放为size*size
+// 缩小时取源区域内像素的平均值，放大时取最近的像素
+func ResizeSquare(img image.Image, size int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	if size <= 0 {
+		return dst
+	}
+	src := CropSquare(img)
+	n := src.Dx()
+	if n == 0 {
+		return dst
+	}
+
+	for y := 0; y < size; y++ {
+		sy0, sy1 := span(y, size, n)
+		for x := 0; x < size; x++ {
+			sx0, sx1 := span(x, size, n)
+			var r, g, b, a, cnt uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, ca := img.At(src.Min.X+sx, src.Min.Y+sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					cnt++
+				}
+			}
+			dst.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(r / cnt),
+				G: uint16(g / cnt),
+				B: uint16(b / cnt),
+				A: uint16(a / cnt),
+			})
+		}
+	}
+
+	return dst
+}
+
+// 目标像素i对应的源像素区间[start, end)，至少包含一个像素
+func span(i, size, n int) (int, int) {
+	start := i * n / size
+	end := (i + 1) * n / size
+	if end <= start {
+		end = start + 1
+	}
+	return start, end
+}
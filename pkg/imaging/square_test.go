@@ -0,0 +1,51 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestCropSquare(t *testing.T) {
+	cases := []struct {
+		rect image.Rectangle
+		want image.Rectangle
+	}{
+		{image.Rect(0, 0, 300, 100), image.Rect(100, 0, 200, 100)},
+		{image.Rect(0, 0, 100, 300), image.Rect(0, 100, 100, 200)},
+		{image.Rect(10, 10, 60, 60), image.Rect(10, 10, 60, 60)},
+	}
+	for _, c := range cases {
+		got := CropSquare(image.NewRGBA(c.rect))
+		if got != c.want {
+			t.Errorf("CropSquare(%v) = %v, want %v", c.rect, got, c.want)
+		}
+	}
+}
+
+func TestResizeSquare(t *testing.T) {
+	// 左半边红色，右半边蓝色，中间正方形裁剪后仍然左右各半
+	img := image.NewRGBA(image.Rect(0, 0, 400, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 400; x++ {
+			if x < 200 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+
+	for _, size := range []int{64, 256} {
+		dst := ResizeSquare(img, size)
+		if dst.Bounds().Dx() != size || dst.Bounds().Dy() != size {
+			t.Fatalf("size = %v, want %d", dst.Bounds(), size)
+		}
+		if c := dst.RGBAAt(0, 0); c != (color.RGBA{R: 255, A: 255}) {
+			t.Errorf("size %d left pixel = %v", size, c)
+		}
+		if c := dst.RGBAAt(size-1, size-1); c != (color.RGBA{B: 255, A: 255}) {
+			t.Errorf("size %d right pixel = %v", size, c)
+		}
+	}
+}
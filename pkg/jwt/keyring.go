@@ -0,0 +1,246 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+var (
+	ErrUnknownKey   = errors.New("unknown signing key")
+	ErrNoSigningKey = errors.New("no private key to sign token")
+	ErrNoAccessKey  = errors.New("neither access secret nor access keys configured")
+)
+
+type (
+	// Key 签名密钥，Id会写入token头部的kid
+	// HS256使用Secret，RS256和EdDSA使用PEM格式的密钥文件，校验方只需要配置公钥
+	Key struct {
+		Id             string `json:",optional"`
+		Algorithm      string `json:",default=HS256,options=HS256|RS256|EdDSA"`
+		Secret         string `json:",optional"`
+		PrivateKeyFile string `json:",optional"`
+		PublicKeyFile  string `json:",optional"`
+	}
+
+	// Keyring 签发时使用当前密钥，校验时根据kid选择密钥
+	// 轮换密钥时把旧密钥放到previous中，旧token在过期前仍然可以通过校验
+	Keyring struct {
+		current Key
+		signer  *keyEntry
+		keys    map[string]*keyEntry
+	}
+
+	keyEntry struct {
+		id        string
+		method    jwt.SigningMethod
+		signKey   interface{}
+		verifyKey interface{}
+	}
+)
+
+// NewKeyring 没有kid的旧token使用Id为空的密钥校验
+func NewKeyring(current Key, previous ...Key) (*Keyring, error) {
+	k := &Keyring{
+		current: current,
+		keys:    make(map[string]*keyEntry, len(previous)+1),
+	}
+	for _, key := range append(previous, current) {
+		entry, err := loadKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("load key %q: %w", key.Id, err)
+		}
+		k.keys[key.Id] = entry
+	}
+	k.signer = k.keys[current.Id]
+
+	return k, nil
+}
+
+func MustNewKeyring(current Key, previous ...Key) *Keyring {
+	k, err := NewKeyring(current, previous...)
+	if err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+// NewAccessKeyring 根据配置创建access token的keyring
+// keys为空时只使用secret，否则第一个为当前密钥，secret作为没有kid的旧密钥继续用于校验
+func NewAccessKeyring(secret string, keys []Key) (*Keyring, error) {
+	if len(secret) == 0 && len(keys) == 0 {
+		return nil, ErrNoAccessKey
+	}
+
+	legacy := Key{Algorithm: AlgHS256, Secret: secret}
+	if len(keys) == 0 {
+		return NewKeyring(legacy)
+	}
+
+	previous := keys[1:]
+	if len(secret) > 0 {
+		previous = append([]Key{legacy}, previous...)
+	}
+	return NewKeyring(keys[0], previous...)
+}
+
+func MustNewAccessKeyring(secret string, keys []Key) *Keyring {
+	k, err := NewAccessKeyring(secret, keys)
+	if err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+// Current 返回签发使用的密钥
+func (k *Keyring) Current() Key {
+	return k.current
+}
+
+func (k *Keyring) sign(claims jwt.MapClaims) (string, error) {
+	if k.signer.signKey == nil {
+		return "", ErrNoSigningKey
+	}
+
+	token := jwt.NewWithClaims(k.signer.method, claims)
+	if len(k.signer.id) > 0 {
+		token.Header[headerKeyId] = k.signer.id
+	}
+	return token.SignedString(k.signer.signKey)
+}
+
+// 校验时签名算法必须与密钥的算法一致，防止用公钥作为HMAC密钥伪造token
+func (k *Keyring) verifyKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header[headerKeyId].(string)
+	entry, ok := k.keys[kid]
+	if !ok || entry.verifyKey == nil {
+		return nil, ErrUnknownKey
+	}
+	if token.Method.Alg() != entry.method.Alg() {
+		return nil, ErrInvalidToken
+	}
+
+	return entry.verifyKey, nil
+}
+
+func loadKey(key Key) (*keyEntry, error) {
+	entry := &keyEntry{id: key.Id}
+	switch key.Algorithm {
+	case "", AlgHS256:
+		entry.method = jwt.SigningMethodHS256
+		if len(key.Secret) > 0 {
+			entry.signKey = []byte(key.Secret)
+			entry.verifyKey = []byte(key.Secret)
+		}
+	case AlgRS256:
+		entry.method = jwt.SigningMethodRS256
+		if err := loadRSAKey(entry, key); err != nil {
+			return nil, err
+		}
+	case AlgEdDSA:
+		entry.method = SigningMethodEdDSA
+		if err := loadEd25519Key(entry, key); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", key.Algorithm)
+	}
+
+	return entry, nil
+}
+
+func loadRSAKey(entry *keyEntry, key Key) error {
+	if len(key.PrivateKeyFile) > 0 {
+		data, err := os.ReadFile(key.PrivateKeyFile)
+		if err != nil {
+			return err
+		}
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+		if err != nil {
+			return err
+		}
+		entry.signKey = privateKey
+		entry.verifyKey = &privateKey.PublicKey
+	}
+	if len(key.PublicKeyFile) > 0 {
+		data, err := os.ReadFile(key.PublicKeyFile)
+		if err != nil {
+			return err
+		}
+		publicKey, err := jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return err
+		}
+		entry.verifyKey = publicKey
+	}
+	if entry.verifyKey == nil {
+		return errors.New("rsa key file is empty")
+	}
+
+	return nil
+}
+
+func loadEd25519Key(entry *keyEntry, key Key) error {
+	if len(key.PrivateKeyFile) > 0 {
+		block, err := readPEM(key.PrivateKeyFile)
+		if err != nil {
+			return err
+		}
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return err
+		}
+		privateKey, ok := parsed.(ed25519.PrivateKey)
+		if !ok {
+			return errors.New("not an ed25519 private key")
+		}
+		entry.signKey = privateKey
+		entry.verifyKey = privateKey.Public()
+	}
+	if len(key.PublicKeyFile) > 0 {
+		block, err := readPEM(key.PublicKeyFile)
+		if err != nil {
+			return err
+		}
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return err
+		}
+		publicKey, ok := parsed.(ed25519.PublicKey)
+		if !ok {
+			return errors.New("not an ed25519 public key")
+		}
+		entry.verifyKey = publicKey
+	}
+	if entry.verifyKey == nil {
+		return errors.New("ed25519 key file is empty")
+	}
+
+	return nil
+}
+
+func readPEM(filename string) (*pem.Block, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid pem data")
+	}
+
+	return block, nil
+}
@@ -0,0 +1,166 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+func writePEM(t *testing.T, dir, name, typ string, der []byte) string {
+	t.Helper()
+	filename := filepath.Join(dir, name)
+	data := pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+	if err := os.WriteFile(filename, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+// 生成一对密钥文件，返回私钥和公钥文件路径
+func genRSAKeyFiles(t *testing.T) (string, string) {
+	t.Helper()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicDer, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	return writePEM(t, dir, "rsa.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(privateKey)),
+		writePEM(t, dir, "rsa.pub", "PUBLIC KEY", publicDer)
+}
+
+func genEd25519KeyFiles(t *testing.T) (string, string) {
+	t.Helper()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateDer, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicDer, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	return writePEM(t, dir, "ed25519.pem", "PRIVATE KEY", privateDer),
+		writePEM(t, dir, "ed25519.pub", "PUBLIC KEY", publicDer)
+}
+
+func TestAsymmetricKeyring(t *testing.T) {
+	rsaPrivate, rsaPublic := genRSAKeyFiles(t)
+	edPrivate, edPublic := genEd25519KeyFiles(t)
+	cases := []struct {
+		alg     string
+		private string
+		public  string
+	}{
+		{AlgRS256, rsaPrivate, rsaPublic},
+		{AlgEdDSA, edPrivate, edPublic},
+	}
+
+	for _, c := range cases {
+		// 签发方持有私钥，校验方只持有公钥
+		signer := MustNewKeyring(Key{Id: c.alg, Algorithm: c.alg, PrivateKeyFile: c.private})
+		verifier := MustNewKeyring(Key{Id: c.alg, Algorithm: c.alg, PublicKeyFile: c.public})
+
+		token, err := BuildTokens(TokenOptions{
+			AccessKeys:   signer,
+			AccessExpire: 3600,
+			Fields:       map[string]interface{}{testUserIdKey: int64(10086)},
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", c.alg, err)
+		}
+		claims, err := ParseToken(token.AccessToken, verifier)
+		if err != nil {
+			t.Fatalf("%s: %v", c.alg, err)
+		}
+		if userId, _ := claims.Int64(testUserIdKey); userId != 10086 {
+			t.Fatalf("%s: expected userId 10086, but got %v", c.alg, claims.Fields[testUserIdKey])
+		}
+
+		if _, err = BuildTokens(TokenOptions{AccessKeys: verifier, AccessExpire: 3600}); err != ErrNoSigningKey {
+			t.Fatalf("%s: expected %v, but got %v", c.alg, ErrNoSigningKey, err)
+		}
+	}
+}
+
+func TestKeyringRejectsAlgorithmConfusion(t *testing.T) {
+	_, rsaPublic := genRSAKeyFiles(t)
+	verifier := MustNewKeyring(Key{Id: "rsa", Algorithm: AlgRS256, PublicKeyFile: rsaPublic})
+
+	// 用公钥内容作为HMAC密钥伪造的token不能通过校验
+	publicPEM, err := os.ReadFile(rsaPublic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().Unix()
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iat": now, "exp": now + 3600})
+	forged.Header[headerKeyId] = "rsa"
+	tokenString, err := forged.SignedString(publicPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ParseToken(tokenString, verifier); err != ErrInvalidToken {
+		t.Fatalf("expected %v, but got %v", ErrInvalidToken, err)
+	}
+}
+
+func TestKeyringJWKS(t *testing.T) {
+	_, rsaPublic := genRSAKeyFiles(t)
+	_, edPublic := genEd25519KeyFiles(t)
+	keys := MustNewKeyring(
+		Key{Id: "rsa", Algorithm: AlgRS256, PublicKeyFile: rsaPublic},
+		Key{Id: "ed", Algorithm: AlgEdDSA, PublicKeyFile: edPublic},
+		Key{Secret: "secret"},
+	)
+
+	// HMAC密钥不会导出
+	set := keys.JWKS()
+	if len(set.Keys) != 2 {
+		t.Fatalf("expected 2 keys, but got %d", len(set.Keys))
+	}
+	ed, rsaKey := set.Keys[0], set.Keys[1]
+	if ed.Kid != "ed" || ed.Kty != "OKP" || ed.Crv != "Ed25519" || len(ed.X) == 0 {
+		t.Fatalf("unexpected ed25519 jwk: %+v", ed)
+	}
+	if rsaKey.Kid != "rsa" || rsaKey.Kty != "RSA" || rsaKey.E != "AQAB" || len(rsaKey.N) == 0 {
+		t.Fatalf("unexpected rsa jwk: %+v", rsaKey)
+	}
+}
+
+func TestNewAccessKeyring(t *testing.T) {
+	_, edPublic := genEd25519KeyFiles(t)
+	keys := MustNewAccessKeyring("legacy", []Key{{Id: "ed", Algorithm: AlgEdDSA, PublicKeyFile: edPublic}})
+	if keys.Current().Id != "ed" {
+		t.Fatalf("expected current key ed, but got %q", keys.Current().Id)
+	}
+
+	// 迁移期间旧的HMAC token仍然可以通过校验
+	token, err := BuildTokens(TokenOptions{AccessSecret: "legacy", AccessExpire: 3600})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ParseToken(token.AccessToken, keys); err != nil {
+		t.Fatalf("expected nil, but got %v", err)
+	}
+}
+
+func TestNewAccessKeyringEmpty(t *testing.T) {
+	if _, err := NewAccessKeyring("", nil); err != ErrNoAccessKey {
+		t.Fatalf("expected ErrNoAccessKey, but got %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const (
+	TypeAccess  = ""
+	TypeRefresh = "refresh"
+
+	headerKeyId = "kid"
+)
+
+var (
+	ErrTokenExpired     = errors.New("token is expired")
+	ErrTokenNotValidYet = errors.New("token is not valid yet")
+)
+
+type (
+	// Claims token中的声明，Fields不包含标准字段
+	Claims struct {
+		Id        string
+		Type      string
+		IssuedAt  int64
+		ExpireAt  int64
+		NotBefore int64
+		Fields    map[string]interface{}
+		// IssuedAtMilli 毫秒级的签发时间，旧版本签发的token没有时由IssuedAt换算
+		IssuedAtMilli int64
+	}
+
+	parseOptions struct {
+		leeway    time.Duration
+		tokenType string
+	}
+
+	// ParseOption 自定义ParseToken的校验规则
+	ParseOption func(opts *parseOptions)
+)
+
+// WithLeeway 允许的时钟偏差，用于exp、nbf和iat的校验
+func WithLeeway(leeway time.Duration) ParseOption {
+	return func(opts *parseOptions) {
+		opts.leeway = leeway
+	}
+}
+
+// WithTokenType 要求token的类型，默认只接受access token
+func WithTokenType(tokenType string) ParseOption {
+	return func(opts *parseOptions) {
+		opts.tokenType = tokenType
+	}
+}
+
+// ParseToken 根据kid从keyring中选择密钥校验签名，并校验有效期和类型
+func ParseToken(tokenString string, keys *Keyring, opts ...ParseOption) (*Claims, error) {
+	var o parseOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	mapClaims := make(jwt.MapClaims)
+	parser := &jwt.Parser{
+		UseJSONNumber: true,
+		// 有效期由下面带时钟偏差的逻辑校验
+		SkipClaimsValidation: true,
+	}
+	_, err := parser.ParseWithClaims(tokenString, mapClaims, keys.verifyKey)
+	if err != nil {
+		var ve *jwt.ValidationError
+		if errors.As(err, &ve) && ve.Inner == ErrUnknownKey {
+			return nil, ErrUnknownKey
+		}
+		return nil, ErrInvalidToken
+	}
+
+	claims := newClaims(mapClaims)
+	if claims.Type != o.tokenType {
+		return nil, ErrInvalidToken
+	}
+	if err = claims.validate(time.Now(), o.leeway); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// Int64 读取自定义字段中的整数
+func (c *Claims) Int64(key string) (int64, bool) {
+	switch v := c.Fields[key].(type) {
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	case float64:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
+// String 读取自定义字段中的字符串
+func (c *Claims) String(key string) (string, bool) {
+	v, ok := c.Fields[key].(string)
+	return v, ok
+}
+
+func (c *Claims) validate(now time.Time, leeway time.Duration) error {
+	// 没有exp的token视为无效
+	if c.ExpireAt == 0 {
+		return ErrInvalidToken
+	}
+	if now.Add(-leeway).Unix() > c.ExpireAt {
+		return ErrTokenExpired
+	}
+	notBefore := now.Add(leeway).Unix()
+	if c.NotBefore > notBefore || c.IssuedAt > notBefore {
+		return ErrTokenNotValidYet
+	}
+
+	return nil
+}
+
+func newClaims(mapClaims jwt.MapClaims) *Claims {
+	// 旧版本签发的token没有jti
+	tokenId, _ := mapClaims[claimTokenId].(string)
+	tokenType, _ := mapClaims[claimType].(string)
+	claims := &Claims{
+		Id:        tokenId,
+		Type:      tokenType,
+		IssuedAt:  int64Claim(mapClaims, "iat"),
+		ExpireAt:  int64Claim(mapClaims, "exp"),
+		NotBefore: int64Claim(mapClaims, "nbf"),
+		Fields:    make(map[string]interface{}, len(mapClaims)),
+	}
+	claims.IssuedAtMilli = int64Claim(mapClaims, claimIssuedAtMilli)
+	if claims.IssuedAtMilli == 0 {
+		claims.IssuedAtMilli = claims.IssuedAt * 1000
+	}
+	for k, v := range mapClaims {
+		switch k {
+		case "exp", "iat", "nbf", claimTokenId, claimType, claimIssuedAtMilli:
+			continue
+		}
+		claims.Fields[k] = v
+	}
+
+	return claims
+}
+
+func int64Claim(claims jwt.MapClaims, key string) int64 {
+	switch v := claims[key].(type) {
+	case json.Number:
+		n, _ := v.Int64()
+		return n
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
+type claimsKey struct{}
+
+// NewContext 保存校验通过的token声明，业务逻辑通过FromContext读取
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext 取出jwt中间件校验通过的token声明
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
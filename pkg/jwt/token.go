@@ -1,46 +1,167 @@
 package jwt
 
 import (
+	"errors"
+	"net/http"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
+	"github.com/dgrijalva/jwt-go/request"
+	"github.com/zeromicro/go-zero/core/utils"
+)
+
+const (
+	claimType    = "type"
+	claimTokenId = "jti"
+	// 毫秒级的签发时间，iat只精确到秒，判断token是否签发于整体吊销之前时使用
+	claimIssuedAtMilli = "iat_ms"
+)
+
+var (
+	ErrInvalidToken    = errors.New("invalid token")
+	ErrRefreshTooEarly = errors.New("refresh token can not be used yet")
 )
 
 type (
 	TokenOptions struct {
-		AccessSecret string
-		AccessExpire int64
-		Fields       map[string]interface{}
+		AccessSecret  string
+		AccessExpire  int64
+		RefreshSecret string
+		RefreshExpire int64
+		RefreshAfter  int64
+		Fields        map[string]interface{}
+		// KeyId 签名密钥的ID，写入token头部的kid，为空时不写入
+		KeyId string
+		// AccessKeys 签发access token使用的密钥，设置后忽略AccessSecret和KeyId
+		AccessKeys *Keyring
+		// RefreshKeys 校验refresh token使用的密钥，为空时只使用RefreshSecret
+		RefreshKeys *Keyring
+		// Leeway 校验refresh token时允许的时钟偏差
+		Leeway time.Duration
 	}
 
 	Token struct {
-		AccessToken  string `json:"access_token"`
-		AccessExpire int64  `json:"access_expire"`
+		AccessToken   string `json:"access_token"`
+		AccessExpire  int64  `json:"access_expire"`
+		RefreshToken  string `json:"refresh_token"`
+		RefreshExpire int64  `json:"refresh_expire"`
+		RefreshAfter  int64  `json:"refresh_after"`
 	}
 )
 
 func BuildTokens(opt TokenOptions) (Token, error) {
 	var token Token
-	now := time.Now().Add(-time.Minute).Unix()
-	accessToken, err := genToken(now, opt.AccessSecret, opt.Fields, opt.AccessExpire)
+	issued := issuedAt()
+	now := issued.Unix()
+	accessKeys := opt.AccessKeys
+	if accessKeys == nil {
+		keys, err := NewKeyring(Key{Id: opt.KeyId, Secret: opt.AccessSecret})
+		if err != nil {
+			return token, err
+		}
+		accessKeys = keys
+	}
+	accessToken, err := genToken(issued, accessKeys, opt.Fields, opt.AccessExpire)
 	if err != nil {
 		return token, err
 	}
 	token.AccessToken = accessToken
 	token.AccessExpire = now + opt.AccessExpire
 
+	// 没有配置RefreshSecret时只签发access token
+	if len(opt.RefreshSecret) == 0 {
+		return token, nil
+	}
+
+	refreshFields := make(map[string]interface{}, len(opt.Fields)+1)
+	for k, v := range opt.Fields {
+		refreshFields[k] = v
+	}
+	refreshFields[claimType] = TypeRefresh
+	refreshKeys, err := NewKeyring(Key{Id: opt.KeyId, Secret: opt.RefreshSecret})
+	if err != nil {
+		return token, err
+	}
+	refreshToken, err := genToken(issued, refreshKeys, refreshFields, opt.RefreshExpire)
+	if err != nil {
+		return token, err
+	}
+	token.RefreshToken = refreshToken
+	token.RefreshExpire = now + opt.RefreshExpire
+	token.RefreshAfter = now + opt.RefreshAfter
+
 	return token, nil
 }
 
-func genToken(iat int64, secretKey string, payloads map[string]interface{}, seconds int64) (string, error) {
+// RefreshTokens 校验refresh token，通过后用其中的自定义字段重新签发一对token
+func RefreshTokens(opt TokenOptions, refreshToken string) (Token, error) {
+	keys := opt.RefreshKeys
+	if keys == nil {
+		var err error
+		if keys, err = NewKeyring(Key{Id: opt.KeyId, Secret: opt.RefreshSecret}); err != nil {
+			return Token{}, err
+		}
+	}
+	claims, err := ParseToken(refreshToken, keys, WithTokenType(TypeRefresh), WithLeeway(opt.Leeway))
+	if err != nil {
+		return Token{}, ErrInvalidToken
+	}
+
+	// 未到RefreshAfter时间不允许刷新
+	if time.Now().Unix() < claims.IssuedAt+opt.RefreshAfter {
+		return Token{}, ErrRefreshTooEarly
+	}
+	opt.Fields = claims.Fields
+
+	return BuildTokens(opt)
+}
+
+// TokenIdFromRequest 从请求头中取出token的ID和过期时间
+// 不校验签名，只能在jwt中间件校验通过之后使用
+func TokenIdFromRequest(r *http.Request) (string, int64, error) {
+	claims, err := ClaimsFromRequest(r)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return claims.Id, claims.ExpireAt, nil
+}
+
+// ClaimsFromRequest 从请求头中取出token的声明，同样不校验签名
+func ClaimsFromRequest(r *http.Request) (*Claims, error) {
+	tokenString, err := request.OAuth2Extractor.ExtractToken(r)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return ParseUnverified(tokenString)
+}
+
+// ParseUnverified 解析token的声明但不校验签名，只能用于已经校验过的token
+func ParseUnverified(tokenString string) (*Claims, error) {
+	claims := make(jwt.MapClaims)
+	parser := &jwt.Parser{UseJSONNumber: true}
+	if _, _, err := parser.ParseUnverified(tokenString, claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return newClaims(claims), nil
+}
+
+// 签发时间往前调整一分钟，兼容服务器之间的时钟偏差
+func issuedAt() time.Time {
+	return time.Now().Add(-time.Minute)
+}
+
+func genToken(issued time.Time, keys *Keyring, payloads map[string]interface{}, seconds int64) (string, error) {
 	claims := make(jwt.MapClaims)
-	claims["exp"] = iat + seconds
-	claims["iat"] = iat
+	claims["exp"] = issued.Unix() + seconds
+	claims["iat"] = issued.Unix()
+	claims[claimIssuedAtMilli] = issued.UnixMilli()
+	claims[claimTokenId] = utils.NewUuid()
 	for k, v := range payloads {
 		claims[k] = v
 	}
-	token := jwt.New(jwt.SigningMethodHS256)
-	token.Claims = claims
 
-	return token.SignedString([]byte(secretKey))
+	return keys.sign(claims)
 }
@@ -0,0 +1,200 @@
+package jwt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const testUserIdKey = "userId"
+
+func signTestToken(t *testing.T, key Key, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if len(key.Id) > 0 {
+		token.Header[headerKeyId] = key.Id
+	}
+	tokenString, err := token.SignedString([]byte(key.Secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokenString
+}
+
+func TestParseToken(t *testing.T) {
+	key := Key{Id: "v1", Secret: "secret-v1"}
+	token, err := BuildTokens(TokenOptions{
+		AccessSecret: key.Secret,
+		AccessExpire: 3600,
+		KeyId:        key.Id,
+		Fields:       map[string]interface{}{testUserIdKey: int64(10086)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := ParseToken(token.AccessToken, MustNewKeyring(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userId, ok := claims.Int64(testUserIdKey); !ok || userId != 10086 {
+		t.Fatalf("expected userId 10086, but got %v", claims.Fields[testUserIdKey])
+	}
+	if len(claims.Id) == 0 || claims.ExpireAt != token.AccessExpire {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+	if claims.IssuedAtMilli/1000 != claims.IssuedAt {
+		t.Fatalf("iat_ms %d does not match iat %d", claims.IssuedAtMilli, claims.IssuedAt)
+	}
+	if _, ok := claims.Fields[claimIssuedAtMilli]; ok {
+		t.Fatal("iat_ms should not be a custom field")
+	}
+}
+
+func TestParseTokenWithoutIssuedAtMilli(t *testing.T) {
+	key := Key{Secret: "secret"}
+	iat := time.Now().Unix()
+	tokenString := signTestToken(t, key, jwt.MapClaims{"iat": iat, "exp": iat + 3600})
+
+	claims, err := ParseToken(tokenString, MustNewKeyring(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.IssuedAtMilli != iat*1000 {
+		t.Fatalf("expected %d, got %d", iat*1000, claims.IssuedAtMilli)
+	}
+}
+
+func TestParseTokenExpired(t *testing.T) {
+	key := Key{Secret: "secret"}
+	now := time.Now().Unix()
+	tokenString := signTestToken(t, key, jwt.MapClaims{
+		"iat": now - 120,
+		"exp": now - 60,
+	})
+
+	if _, err := ParseToken(tokenString, MustNewKeyring(key)); err != ErrTokenExpired {
+		t.Fatalf("expected %v, but got %v", ErrTokenExpired, err)
+	}
+	// 时钟偏差范围内仍然有效
+	if _, err := ParseToken(tokenString, MustNewKeyring(key), WithLeeway(2*time.Minute)); err != nil {
+		t.Fatalf("expected nil with leeway, but got %v", err)
+	}
+}
+
+func TestParseTokenNotValidYet(t *testing.T) {
+	key := Key{Secret: "secret"}
+	now := time.Now().Unix()
+	cases := map[string]jwt.MapClaims{
+		"nbf": {"iat": now, "nbf": now + 120, "exp": now + 3600},
+		"iat": {"iat": now + 120, "exp": now + 3600},
+	}
+	for name, claims := range cases {
+		tokenString := signTestToken(t, key, claims)
+		if _, err := ParseToken(tokenString, MustNewKeyring(key)); err != ErrTokenNotValidYet {
+			t.Fatalf("%s: expected %v, but got %v", name, ErrTokenNotValidYet, err)
+		}
+		if _, err := ParseToken(tokenString, MustNewKeyring(key), WithLeeway(3*time.Minute)); err != nil {
+			t.Fatalf("%s: expected nil with leeway, but got %v", name, err)
+		}
+	}
+}
+
+func TestParseTokenWrongKey(t *testing.T) {
+	now := time.Now().Unix()
+	claims := jwt.MapClaims{"iat": now, "exp": now + 3600}
+
+	// kid相同但密钥不同，签名校验失败
+	tokenString := signTestToken(t, Key{Id: "v1", Secret: "wrong"}, claims)
+	if _, err := ParseToken(tokenString, MustNewKeyring(Key{Id: "v1", Secret: "secret"})); err != ErrInvalidToken {
+		t.Fatalf("expected %v, but got %v", ErrInvalidToken, err)
+	}
+
+	// keyring中没有对应kid的密钥
+	tokenString = signTestToken(t, Key{Id: "v9", Secret: "secret"}, claims)
+	if _, err := ParseToken(tokenString, MustNewKeyring(Key{Id: "v1", Secret: "secret"})); err != ErrUnknownKey {
+		t.Fatalf("expected %v, but got %v", ErrUnknownKey, err)
+	}
+}
+
+func TestParseTokenKeyRotation(t *testing.T) {
+	legacy := Key{Secret: "legacy"}
+	v1 := Key{Id: "v1", Secret: "secret-v1"}
+	v2 := Key{Id: "v2", Secret: "secret-v2"}
+	keys := MustNewKeyring(v2, legacy, v1)
+
+	for _, key := range []Key{legacy, v1, v2} {
+		token, err := BuildTokens(TokenOptions{
+			AccessSecret: key.Secret,
+			AccessExpire: 3600,
+			KeyId:        key.Id,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = ParseToken(token.AccessToken, keys); err != nil {
+			t.Fatalf("key %q: expected nil, but got %v", key.Id, err)
+		}
+	}
+	if keys.Current() != v2 {
+		t.Fatalf("expected current key %v, but got %v", v2, keys.Current())
+	}
+}
+
+func TestParseTokenType(t *testing.T) {
+	key := Key{Secret: "secret"}
+	token, err := BuildTokens(TokenOptions{
+		AccessSecret:  key.Secret,
+		AccessExpire:  3600,
+		RefreshSecret: key.Secret,
+		RefreshExpire: 7200,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// refresh token不能当作access token使用，反之亦然
+	if _, err = ParseToken(token.RefreshToken, MustNewKeyring(key)); err != ErrInvalidToken {
+		t.Fatalf("expected %v, but got %v", ErrInvalidToken, err)
+	}
+	if _, err = ParseToken(token.AccessToken, MustNewKeyring(key), WithTokenType(TypeRefresh)); err != ErrInvalidToken {
+		t.Fatalf("expected %v, but got %v", ErrInvalidToken, err)
+	}
+}
+
+func TestRefreshTokens(t *testing.T) {
+	opt := TokenOptions{
+		AccessSecret:  "access",
+		AccessExpire:  3600,
+		RefreshSecret: "refresh",
+		RefreshExpire: 7200,
+		KeyId:         "v1",
+		Fields:        map[string]interface{}{testUserIdKey: int64(10086)},
+	}
+	token, err := BuildTokens(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opt.Fields = nil
+	refreshed, err := RefreshTokens(opt, token.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := ParseToken(refreshed.AccessToken, MustNewKeyring(Key{Id: "v1", Secret: "access"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userId, ok := claims.Int64(testUserIdKey); !ok || userId != 10086 {
+		t.Fatalf("expected userId 10086, but got %v", claims.Fields[testUserIdKey])
+	}
+
+	opt.RefreshAfter = 3600
+	if _, err = RefreshTokens(opt, token.RefreshToken); err != ErrRefreshTooEarly {
+		t.Fatalf("expected %v, but got %v", ErrRefreshTooEarly, err)
+	}
+	if _, err = RefreshTokens(opt, token.AccessToken); err != ErrInvalidToken {
+		t.Fatalf("expected %v, but got %v", ErrInvalidToken, err)
+	}
+}
@@ -0,0 +1,84 @@
+package limit
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// 已锁定时返回-1；否则次数加一，第一次时设置过期时间，超过quota时开始锁定并返回-1
+// 检查、计数和锁定在脚本中执行，并发的请求也不会超过quota次
+var takeFailScript = redis.NewScript(`if redis.call("EXISTS", KEYS[2]) == 1 then
+    return -1
+end
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+    redis.call("EXPIRE", KEYS[1], ARGV[1])
+end
+if count > tonumber(ARGV[2]) then
+    redis.call("SET", KEYS[2], "1", "EX", ARGV[3])
+    redis.call("DEL", KEYS[1])
+    return -1
+end
+return count`)
+
+type (
+	// FailLimit 限制连续失败的次数，window秒内最多尝试quota次，超过后锁定lock秒
+	// 每次尝试在校验之前计数，校验成功后调用ResetCtx清零
+	FailLimit struct {
+		window    int
+		quota     int
+		lock      int
+		store     failStore
+		keyPrefix string
+	}
+
+	failStore interface {
+		ScriptRunCtx(ctx context.Context, script *redis.Script, keys []string, args ...any) (any, error)
+		DelCtx(ctx context.Context, keys ...string) (int, error)
+	}
+)
+
+// NewFailLimit 计数和锁定的键分别为keyPrefix+"fail#"+key和keyPrefix+"lock#"+key
+func NewFailLimit(window, quota, lock int, store *redis.Redis, keyPrefix string) *FailLimit {
+	return &FailLimit{
+		window:    window,
+		quota:     quota,
+		lock:      lock,
+		store:     store,
+		keyPrefix: keyPrefix,
+	}
+}
+
+// TakeCtx 占用一次尝试的机会，已经锁定或者超过quota次时返回false，超过时同时开始锁定
+func (l *FailLimit) TakeCtx(ctx context.Context, key string) (bool, error) {
+	resp, err := l.store.ScriptRunCtx(ctx, takeFailScript, []string{l.failKey(key), l.lockKey(key)},
+		strconv.Itoa(l.window),
+		strconv.Itoa(l.quota),
+		strconv.Itoa(l.lock),
+	)
+	if err != nil {
+		return false, err
+	}
+	count, ok := resp.(int64)
+	if !ok {
+		return false, ErrUnknownCode
+	}
+
+	return count > 0, nil
+}
+
+// ResetCtx 清零尝试次数，不解除锁定
+func (l *FailLimit) ResetCtx(ctx context.Context, key string) error {
+	_, err := l.store.DelCtx(ctx, l.failKey(key))
+	return err
+}
+
+func (l *FailLimit) failKey(key string) string {
+	return l.keyPrefix + "fail#" + key
+}
+
+func (l *FailLimit) lockKey(key string) string {
+	return l.keyPrefix + "lock#" + key
+}
@@ -0,0 +1,139 @@
+package limit
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// fakeFailStore 在内存中按takeFailScript的语义计数和锁定，不处理过期
+type fakeFailStore struct {
+	mu     sync.Mutex
+	counts map[string]int64
+	locks  map[string]string
+}
+
+func newFakeFailStore() *fakeFailStore {
+	return &fakeFailStore{counts: make(map[string]int64), locks: make(map[string]string)}
+}
+
+func (s *fakeFailStore) ScriptRunCtx(_ context.Context, _ *redis.Script, keys []string, args ...any) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.locks[keys[1]]; ok {
+		return int64(-1), nil
+	}
+	s.counts[keys[0]]++
+	quota, _ := strconv.ParseInt(args[1].(string), 10, 64)
+	if s.counts[keys[0]] > quota {
+		s.locks[keys[1]] = args[2].(string)
+		delete(s.counts, keys[0])
+		return int64(-1), nil
+	}
+	return s.counts[keys[0]], nil
+}
+
+func (s *fakeFailStore) DelCtx(_ context.Context, keys ...string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		delete(s.counts, key)
+	}
+	return len(keys), nil
+}
+
+func newTestFailLimit(quota int) (*FailLimit, *fakeFailStore) {
+	store := newFakeFailStore()
+	return &FailLimit{
+		window:    60,
+		quota:     quota,
+		lock:      900,
+		store:     store,
+		keyPrefix: "test#",
+	}, store
+}
+
+func TestFailLimit(t *testing.T) {
+	l, store := newTestFailLimit(3)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		ok, err := l.TakeCtx(ctx, "a")
+		if err != nil || !ok {
+			t.Fatalf("take %d: ok = %v, err = %v", i, ok, err)
+		}
+	}
+	// 第quota+1次被拒绝并开始锁定
+	ok, err := l.TakeCtx(ctx, "a")
+	if err != nil || ok {
+		t.Fatalf("take over quota: ok = %v, err = %v", ok, err)
+	}
+	if store.locks["test#lock#a"] != "900" {
+		t.Errorf("locks = %v", store.locks)
+	}
+	// 锁定期间不再计数
+	if ok, _ = l.TakeCtx(ctx, "a"); ok {
+		t.Error("take while locked")
+	}
+	if _, ok := store.counts["test#fail#a"]; ok {
+		t.Errorf("counted while locked: %v", store.counts)
+	}
+	// 清零不解除锁定
+	l.ResetCtx(ctx, "a")
+	if ok, _ = l.TakeCtx(ctx, "a"); ok {
+		t.Error("take after reset while locked")
+	}
+
+	// 不同的key互不影响
+	if ok, _ = l.TakeCtx(ctx, "b"); !ok {
+		t.Error("take other key rejected")
+	}
+}
+
+func TestFailLimitReset(t *testing.T) {
+	l, _ := newTestFailLimit(2)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		l.TakeCtx(ctx, "a")
+	}
+	if err := l.ResetCtx(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if ok, _ := l.TakeCtx(ctx, "a"); !ok {
+			t.Fatalf("take %d after reset rejected", i)
+		}
+	}
+}
+
+// 并发的请求在比较之前计数，通过的次数不会超过quota
+func TestFailLimitConcurrent(t *testing.T) {
+	l, _ := newTestFailLimit(5)
+	ctx := context.Background()
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		passed int
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if ok, _ := l.TakeCtx(ctx, "a"); ok {
+				mu.Lock()
+				passed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if passed != 5 {
+		t.Errorf("passed = %d, want 5", passed)
+	}
+}
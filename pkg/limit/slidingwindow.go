@@ -0,0 +1,117 @@
+package limit
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/utils"
+)
+
+// 滑动窗口：用有序集合记录窗口内每次请求的时间，
+// 返回0表示放行，否则返回窗口内最早一次请求过期还需要等待的毫秒数
+var slidingWindowScript = redis.NewScript(`local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local quota = tonumber(ARGV[3])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], 0, now - window)
+if redis.call("ZCARD", KEYS[1]) < quota then
+    redis.call("ZADD", KEYS[1], now, ARGV[4])
+    redis.call("PEXPIRE", KEYS[1], window)
+    return 0
+end
+local oldest = redis.call("ZRANGE", KEYS[1], 0, 0, "WITHSCORES")
+return tonumber(oldest[2]) + window - now`)
+
+var ErrUnknownCode = errors.New("unknown status code")
+
+type (
+	// SlidingWindowLimit 基于redis的滑动窗口限流，window秒内最多允许quota次请求
+	SlidingWindowLimit struct {
+		window    int
+		quota     int
+		store     store
+		keyPrefix string
+		now       func() time.Time
+	}
+
+	// Reservation 一次占用配额的结果，OK为false时Wait为需要等待的时间
+	// 占用后请求没有真正执行时调用CancelCtx归还配额
+	Reservation struct {
+		OK     bool
+		Wait   time.Duration
+		key    string
+		member string
+	}
+
+	store interface {
+		ScriptRunCtx(ctx context.Context, script *redis.Script, keys []string, args ...any) (any, error)
+		ZremCtx(ctx context.Context, key string, values ...any) (int, error)
+	}
+)
+
+func NewSlidingWindowLimit(window, quota int, store *redis.Redis, keyPrefix string) *SlidingWindowLimit {
+	return &SlidingWindowLimit{
+		window:    window,
+		quota:     quota,
+		store:     store,
+		keyPrefix: keyPrefix,
+		now:       time.Now,
+	}
+}
+
+// TakeCtx 尝试占用一次配额，被拒绝时返回需要等待的时间
+// quota小于等于0时不限流
+func (l *SlidingWindowLimit) TakeCtx(ctx context.Context, key string) (bool, time.Duration, error) {
+	r, err := l.ReserveCtx(ctx, key)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return r.OK, r.Wait, nil
+}
+
+// ReserveCtx 与TakeCtx相同，返回的Reservation可以用于归还配额
+func (l *SlidingWindowLimit) ReserveCtx(ctx context.Context, key string) (*Reservation, error) {
+	if l.quota <= 0 || l.window <= 0 {
+		return &Reservation{OK: true}, nil
+	}
+
+	now := l.now().UnixMilli()
+	r := &Reservation{
+		key:    l.keyPrefix + key,
+		member: strconv.FormatInt(now, 10) + "-" + utils.NewUuid(),
+	}
+	resp, err := l.store.ScriptRunCtx(ctx, slidingWindowScript, []string{r.key},
+		strconv.FormatInt(now, 10),
+		strconv.Itoa(l.window*1000),
+		strconv.Itoa(l.quota),
+		r.member,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	wait, ok := resp.(int64)
+	if !ok {
+		return nil, ErrUnknownCode
+	}
+	if wait > 0 {
+		r.Wait = time.Duration(wait) * time.Millisecond
+		return r, nil
+	}
+
+	r.OK = true
+	return r, nil
+}
+
+// CancelCtx 归还占用的配额，被拒绝或不限流时什么也不做
+func (l *SlidingWindowLimit) CancelCtx(ctx context.Context, r *Reservation) error {
+	if r == nil || !r.OK || len(r.member) == 0 {
+		return nil
+	}
+
+	_, err := l.store.ZremCtx(ctx, r.key, r.member)
+	return err
+}
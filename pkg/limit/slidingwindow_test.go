@@ -0,0 +1,172 @@
+package limit
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+type member struct {
+	score int64
+	value string
+}
+
+// fakeStore 在内存中按slidingWindowScript的语义执行有序集合命令
+type fakeStore struct {
+	sets map[string][]member
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{sets: make(map[string][]member)}
+}
+
+func (s *fakeStore) ScriptRunCtx(_ context.Context, _ *redis.Script, keys []string, args ...any) (any, error) {
+	now, _ := strconv.ParseInt(args[0].(string), 10, 64)
+	window, _ := strconv.ParseInt(args[1].(string), 10, 64)
+	quota, _ := strconv.Atoi(args[2].(string))
+
+	// ZREMRANGEBYSCORE key 0 now-window，两端都包含
+	var kept []member
+	for _, m := range s.sets[keys[0]] {
+		if m.score > now-window {
+			kept = append(kept, m)
+		}
+	}
+	if len(kept) < quota {
+		kept = append(kept, member{score: now, value: args[3].(string)})
+		sort.SliceStable(kept, func(i, j int) bool { return kept[i].score < kept[j].score })
+		s.sets[keys[0]] = kept
+		return int64(0), nil
+	}
+
+	s.sets[keys[0]] = kept
+	return kept[0].score + window - now, nil
+}
+
+func (s *fakeStore) ZremCtx(_ context.Context, key string, values ...any) (int, error) {
+	var removed int
+	for _, v := range values {
+		set := s.sets[key]
+		for i, m := range set {
+			if m.value == v {
+				s.sets[key] = append(set[:i], set[i+1:]...)
+				removed++
+				break
+			}
+		}
+	}
+	return removed, nil
+}
+
+func newTestLimit(window, quota int, now *time.Time) (*SlidingWindowLimit, *fakeStore) {
+	store := newFakeStore()
+	return &SlidingWindowLimit{
+		window:    window,
+		quota:     quota,
+		store:     store,
+		keyPrefix: "test#",
+		now:       func() time.Time { return *now },
+	}, store
+}
+
+func TestSlidingWindowLimit(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	l, _ := newTestLimit(60, 2, &now)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		ok, _, err := l.TakeCtx(ctx, "13800000000")
+		if err != nil || !ok {
+			t.Fatalf("take %d: ok=%v err=%v", i, ok, err)
+		}
+		now = now.Add(10 * time.Second)
+	}
+
+	// 窗口内超过配额，等待时间为最早一次请求过期的剩余时间
+	ok, wait, err := l.TakeCtx(ctx, "13800000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok || wait != 40*time.Second {
+		t.Fatalf("expected reject with 40s wait, got ok=%v wait=%v", ok, wait)
+	}
+
+	// 不同的key互不影响
+	if ok, _, _ = l.TakeCtx(ctx, "13900000000"); !ok {
+		t.Fatal("other key should not be limited")
+	}
+}
+
+func TestSlidingWindowLimitBoundary(t *testing.T) {
+	start := time.UnixMilli(1700000000000)
+	now := start
+	l, _ := newTestLimit(60, 1, &now)
+	ctx := context.Background()
+
+	if ok, _, _ := l.TakeCtx(ctx, "k"); !ok {
+		t.Fatal("first take should pass")
+	}
+
+	// 窗口结束前1毫秒仍然被拒绝
+	now = start.Add(time.Minute - time.Millisecond)
+	ok, wait, _ := l.TakeCtx(ctx, "k")
+	if ok || wait != time.Millisecond {
+		t.Fatalf("expected reject with 1ms wait, got ok=%v wait=%v", ok, wait)
+	}
+
+	// 正好经过一个窗口时，最早的请求已经过期
+	now = start.Add(time.Minute)
+	if ok, _, _ = l.TakeCtx(ctx, "k"); !ok {
+		t.Fatal("take at window boundary should pass")
+	}
+}
+
+func TestSlidingWindowLimitCancel(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	l, store := newTestLimit(60, 1, &now)
+	ctx := context.Background()
+
+	r, err := l.ReserveCtx(ctx, "k")
+	if err != nil || !r.OK {
+		t.Fatalf("reserve: %+v %v", r, err)
+	}
+	if err = l.CancelCtx(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(store.sets["test#k"]); n != 0 {
+		t.Fatalf("expected empty window after cancel, got %d", n)
+	}
+
+	r, _ = l.ReserveCtx(ctx, "k")
+	if !r.OK {
+		t.Fatal("quota should be returned after cancel")
+	}
+	rejected, _ := l.ReserveCtx(ctx, "k")
+	if rejected.OK {
+		t.Fatal("expected reject")
+	}
+	// 被拒绝的Reservation没有占用配额，归还不影响已占用的
+	if err = l.CancelCtx(ctx, rejected); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(store.sets["test#k"]); n != 1 {
+		t.Fatalf("expected 1 entry, got %d", n)
+	}
+}
+
+func TestSlidingWindowLimitDisabled(t *testing.T) {
+	now := time.Now()
+	l, store := newTestLimit(60, 0, &now)
+	for i := 0; i < 10; i++ {
+		if ok, _, err := l.TakeCtx(context.Background(), "k"); err != nil || !ok {
+			t.Fatalf("disabled limit should always pass: ok=%v err=%v", ok, err)
+		}
+	}
+	if len(store.sets) != 0 {
+		t.Fatal("disabled limit should not touch redis")
+	}
+}
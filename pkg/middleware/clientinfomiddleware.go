@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+
+	"myBeyond/pkg/util"
+)
+
+// 客户端上报的设备名称，为空时只记录User-Agent
+const headerDeviceName = "X-Device-Name"
+
+// ClientInfoMiddleware 把设备名称、User-Agent和客户端IP放到context中
+// 客户端IP只从可信代理追加的X-Forwarded-For中读取
+type ClientInfoMiddleware struct {
+	trustedProxies []*net.IPNet
+}
+
+func NewClientInfoMiddleware(trustedProxies []*net.IPNet) *ClientInfoMiddleware {
+	return &ClientInfoMiddleware{
+		trustedProxies: trustedProxies,
+	}
+}
+
+func (m *ClientInfoMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := util.WithClientInfo(r.Context(), util.ClientInfo{
+			Device:    r.Header.Get(headerDeviceName),
+			UserAgent: r.UserAgent(),
+			Ip:        util.ClientIp(r, m.trustedProxies),
+		})
+
+		next(w, r.WithContext(ctx))
+	}
+}
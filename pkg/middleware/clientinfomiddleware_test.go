@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myBeyond/pkg/util"
+)
+
+func TestClientInfoMiddleware(t *testing.T) {
+	proxies, err := util.ParseCIDRs([]string{"10.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got util.ClientInfo
+	handler := NewClientInfoMiddleware(proxies).Handle(func(w http.ResponseWriter, r *http.Request) {
+		got = util.ClientInfoFromContext(r.Context())
+	})
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/login", nil)
+	r.RemoteAddr = "10.0.0.1:12345"
+	r.Header.Set("X-Forwarded-For", "1.1.1.1, 2.2.2.2")
+	r.Header.Set("User-Agent", "beyond/1.0")
+	r.Header.Set(headerDeviceName, "iPhone")
+	handler(httptest.NewRecorder(), r)
+
+	want := util.ClientInfo{Device: "iPhone", UserAgent: "beyond/1.0", Ip: "2.2.2.2"}
+	if got != want {
+		t.Errorf("client info = %+v, want %+v", got, want)
+	}
+}
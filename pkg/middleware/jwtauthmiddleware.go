@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"myBeyond/pkg/jwt"
+
+	"github.com/dgrijalva/jwt-go/request"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// JwtAuthMiddleware 使用keyring校验access token，替代go-zero内置只支持HMAC的jwt校验
+// 与go-zero一样把token中的自定义字段放到context中
+type JwtAuthMiddleware struct {
+	keys *jwt.Keyring
+	opts []jwt.ParseOption
+}
+
+func NewJwtAuthMiddleware(keys *jwt.Keyring, opts ...jwt.ParseOption) *JwtAuthMiddleware {
+	return &JwtAuthMiddleware{
+		keys: keys,
+		opts: opts,
+	}
+}
+
+func (m *JwtAuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tokenString, err := request.OAuth2Extractor.ExtractToken(r)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		claims, err := jwt.ParseToken(tokenString, m.keys, m.opts...)
+		if err != nil {
+			logx.WithContext(r.Context()).Infof("ParseToken error: %v", err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		ctx := jwt.NewContext(r.Context(), claims)
+		for k, v := range claims.Fields {
+			ctx = context.WithValue(ctx, k, v)
+		}
+
+		next(w, r.WithContext(ctx))
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"myBeyond/pkg/jwt"
+	"myBeyond/pkg/session"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// SessionMiddleware 拒绝所属会话已经被删除的token，同时刷新会话的最后活跃时间
+// 没有会话ID的token是会话功能上线前签发的，直接放行
+type SessionMiddleware struct {
+	store   *session.Store
+	userKey string
+}
+
+func NewSessionMiddleware(store *session.Store, userKey string) *SessionMiddleware {
+	return &SessionMiddleware{
+		store:   store,
+		userKey: userKey,
+	}
+}
+
+func (m *SessionMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims, err := jwt.ClaimsFromRequest(r)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		sessionId, ok := claims.String(session.ClaimKey)
+		userId, exists := claims.Fields[m.userKey]
+		if !ok || len(sessionId) == 0 || !exists {
+			next(w, r)
+			return
+		}
+
+		// redis不可用时无法确认会话是否已经被删除，拒绝请求
+		uid := fmt.Sprint(userId)
+		exists, err = m.store.Touch(r.Context(), uid, sessionId)
+		if err != nil {
+			logx.WithContext(r.Context()).Errorf("Touch userId: %s sessionId: %s error: %v", uid, sessionId, err)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if !exists {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		next(w, r)
+	}
+}
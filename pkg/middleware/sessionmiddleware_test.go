@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myBeyond/pkg/session"
+)
+
+func TestSessionMiddlewareFailClosed(t *testing.T) {
+	var called bool
+	handler := NewSessionMiddleware(session.NewStore(newClosedRedis(t), 3600), "userId").Handle(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	// 有会话ID的token在redis不可用时拒绝
+	w := httptest.NewRecorder()
+	handler(w, newAuthRequest(t, map[string]interface{}{"userId": 10, session.ClaimKey: "sid"}))
+	if called || w.Code != http.StatusServiceUnavailable {
+		t.Errorf("called = %v, code = %d", called, w.Code)
+	}
+
+	// 会话功能上线前签发的token不访问redis
+	w = httptest.NewRecorder()
+	handler(w, newAuthRequest(t, map[string]interface{}{"userId": 10}))
+	if !called {
+		t.Errorf("token without session rejected, code = %d", w.Code)
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"myBeyond/pkg/signature"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// SignatureMiddleware 校验请求的HMAC签名，签名不通过返回403
+type SignatureMiddleware struct {
+	verifier *signature.Verifier
+}
+
+func NewSignatureMiddleware(verifier *signature.Verifier) *SignatureMiddleware {
+	return &SignatureMiddleware{verifier: verifier}
+}
+
+func (m *SignatureMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := m.verifier.Verify(r); err != nil {
+			logx.WithContext(r.Context()).Infof("verify signature %s %s error: %v", r.Method, r.URL.Path, err)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		next(w, r)
+	}
+}
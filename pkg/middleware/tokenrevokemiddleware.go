@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"myBeyond/pkg/jwt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// TokenRevokeMiddleware 拒绝已经被吊销的token，需要挂在jwt校验之后的路由上
+// userKey为token中用户ID的字段名，用于判断用户的token是否被整体吊销
+type TokenRevokeMiddleware struct {
+	store   *jwt.RevokeStore
+	userKey string
+}
+
+func NewTokenRevokeMiddleware(store *jwt.RevokeStore, userKey string) *TokenRevokeMiddleware {
+	return &TokenRevokeMiddleware{
+		store:   store,
+		userKey: userKey,
+	}
+}
+
+func (m *TokenRevokeMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims, err := jwt.ClaimsFromRequest(r)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		revoked, err := m.isRevoked(r, claims)
+		if err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if revoked {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// redis不可用时返回错误，无法确认token是否被吊销时拒绝请求
+func (m *TokenRevokeMiddleware) isRevoked(r *http.Request, claims *jwt.Claims) (bool, error) {
+	revoked, err := m.store.IsRevoked(r.Context(), claims.Id)
+	if err != nil {
+		logx.WithContext(r.Context()).Errorf("IsRevoked tokenId: %s error: %v", claims.Id, err)
+		return false, err
+	}
+	if revoked {
+		return true, nil
+	}
+
+	userId, ok := claims.Fields[m.userKey]
+	if !ok {
+		return false, nil
+	}
+	uid := fmt.Sprint(userId)
+	revoked, err = m.store.IsUserRevoked(r.Context(), uid, claims.IssuedAtMilli)
+	if err != nil {
+		logx.WithContext(r.Context()).Errorf("IsUserRevoked userId: %s error: %v", uid, err)
+		return false, err
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myBeyond/pkg/jwt"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// newClosedRedis 返回连接会被拒绝的redis，模拟redis不可用
+func newClosedRedis(t *testing.T) *redis.Redis {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	return redis.New(addr)
+}
+
+func newAuthRequest(t *testing.T, fields map[string]interface{}) *http.Request {
+	token, err := jwt.BuildTokens(jwt.TokenOptions{
+		AccessSecret:  "access-secret",
+		AccessExpire:  3600,
+		RefreshSecret: "refresh-secret",
+		RefreshExpire: 7200,
+		Fields:        fields,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest(http.MethodGet, "/v1/user/info", nil)
+	r.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return r
+}
+
+// redis不可用时无法确认token是否被吊销，拒绝请求
+func TestTokenRevokeMiddlewareFailClosed(t *testing.T) {
+	var called bool
+	handler := NewTokenRevokeMiddleware(jwt.NewRevokeStore(newClosedRedis(t)), "userId").Handle(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	w := httptest.NewRecorder()
+	handler(w, newAuthRequest(t, map[string]interface{}{"userId": 10}))
+	if called || w.Code != http.StatusServiceUnavailable {
+		t.Errorf("called = %v, code = %d", called, w.Code)
+	}
+}
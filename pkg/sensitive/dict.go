@@ -0,0 +1,148 @@
+package sensitive
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type (
+	Conf struct {
+		// 词库文件，每行一个词，#开头的行是注释；为空时不启用
+		File string `json:",optional"`
+		// 检查文件是否修改的间隔，秒，0表示不自动重新加载
+		ReloadInterval int64 `json:",default=60"`
+	}
+
+	// Dict 从文件加载的词库，nil的Dict不匹配任何词
+	Dict struct {
+		file    string
+		matcher atomic.Pointer[Matcher]
+
+		mu      sync.Mutex
+		modTime time.Time
+		size    int64
+
+		done chan struct{}
+		once sync.Once
+	}
+)
+
+// NewDict 加载词库，ReloadInterval大于0时在后台定时检查文件是否修改
+func NewDict(c Conf) (*Dict, error) {
+	d := &Dict{
+		file: c.File,
+		done: make(chan struct{}),
+	}
+	if err := d.Reload(); err != nil {
+		return nil, err
+	}
+	if c.ReloadInterval > 0 {
+		go d.watch(time.Duration(c.ReloadInterval) * time.Second)
+	}
+
+	return d, nil
+}
+
+// MustNewDict 未配置词库文件时返回nil，加载失败时退出
+func MustNewDict(c Conf) *Dict {
+	if len(c.File) == 0 {
+		return nil
+	}
+	d, err := NewDict(c)
+	logx.Must(err)
+	return d
+}
+
+// ParseWords 解析词库内容，忽略空行和#开头的注释
+func ParseWords(data []byte) []string {
+	var words []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	return words
+}
+
+// Reload 文件有修改时重新构建自动机，重新加载失败时继续使用原来的词库
+func (d *Dict) Reload() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	info, err := os.Stat(d.file)
+	if err != nil {
+		return err
+	}
+	if d.matcher.Load() != nil && info.ModTime().Equal(d.modTime) && info.Size() == d.size {
+		return nil
+	}
+
+	data, err := os.ReadFile(d.file)
+	if err != nil {
+		return err
+	}
+	m := NewMatcher(ParseWords(data))
+	d.matcher.Store(m)
+	d.modTime, d.size = info.ModTime(), info.Size()
+	logx.Infof("sensitive dict loaded file: %s words: %d", d.file, m.Len())
+
+	return nil
+}
+
+// Close 停止后台重新加载
+func (d *Dict) Close() {
+	if d == nil {
+		return
+	}
+	d.once.Do(func() {
+		close(d.done)
+	})
+}
+
+func (d *Dict) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.done:
+			return
+		case <-ticker.C:
+			if err := d.Reload(); err != nil {
+				logx.Errorf("sensitive dict reload file: %s error: %v", d.file, err)
+			}
+		}
+	}
+}
+
+// Matcher 当前使用的自动机
+func (d *Dict) Matcher() *Matcher {
+	if d == nil {
+		return nil
+	}
+	return d.matcher.Load()
+}
+
+// Contains text中是否包含敏感词
+func (d *Dict) Contains(text string) bool {
+	return d.Matcher().Contains(text)
+}
+
+// Match 返回text中命中的词
+func (d *Dict) Match(text string) []string {
+	return d.Matcher().Match(text)
+}
+
+// Mask 把命中的字符替换为DefaultMask
+func (d *Dict) Mask(text string) string {
+	return d.Matcher().Mask(text, DefaultMask)
+}
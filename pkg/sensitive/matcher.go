@@ -0,0 +1,195 @@
+// Package sensitive 基于Aho-Corasick自动机的敏感词匹配。
+//
+// 匹配不区分大小写，命中的位置按rune计算。Dict从文件加载词库，
+// 文件修改后重新构建自动机并原子替换，正在进行的匹配不受影响。
+package sensitive
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DefaultMask 打码时默认的替换字符
+const DefaultMask = '*'
+
+type (
+	// Hit 一次命中，[Start, End)为text中的rune下标
+	Hit struct {
+		Word  string
+		Start int
+		End   int
+	}
+
+	// Matcher 构建完成后只读，可以并发使用
+	Matcher struct {
+		root *node
+		size int
+	}
+
+	node struct {
+		children map[rune]*node
+		fail     *node
+		// 以当前节点结尾的词，没有时为空
+		word string
+		// 词的长度(rune)
+		length int
+		// fail链上最近的词尾节点，用于输出被包含的短词
+		output *node
+	}
+)
+
+// NewMatcher 用words构建自动机，忽略空词和重复的词
+func NewMatcher(words []string) *Matcher {
+	m := &Matcher{root: newNode()}
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if len(w) == 0 {
+			continue
+		}
+		cur := m.root
+		var n int
+		for _, r := range w {
+			r = unicode.ToLower(r)
+			next, ok := cur.children[r]
+			if !ok {
+				next = newNode()
+				cur.children[r] = next
+			}
+			cur = next
+			n++
+		}
+		if len(cur.word) == 0 {
+			cur.word = w
+			cur.length = n
+			m.size++
+		}
+	}
+	m.build()
+
+	return m
+}
+
+func newNode() *node {
+	return &node{children: make(map[rune]*node)}
+}
+
+// build 按层序计算fail指针和output指针
+func (m *Matcher) build() {
+	queue := make([]*node, 0, len(m.root.children))
+	for _, child := range m.root.children {
+		child.fail = m.root
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range cur.children {
+			fail := cur.fail
+			for fail != nil && fail.children[r] == nil {
+				fail = fail.fail
+			}
+			if fail == nil {
+				child.fail = m.root
+			} else {
+				child.fail = fail.children[r]
+			}
+			if len(child.fail.word) > 0 {
+				child.output = child.fail
+			} else {
+				child.output = child.fail.output
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Len 词库中词的数量
+func (m *Matcher) Len() int {
+	if m == nil {
+		return 0
+	}
+	return m.size
+}
+
+// FindAll 返回text中所有命中，包括重叠和互相包含的词，按结束位置排序
+func (m *Matcher) FindAll(text string) []Hit {
+	var hits []Hit
+	m.scan(text, func(h Hit) bool {
+		hits = append(hits, h)
+		return true
+	})
+	return hits
+}
+
+// Contains text中是否包含敏感词
+func (m *Matcher) Contains(text string) bool {
+	var found bool
+	m.scan(text, func(Hit) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// Match 返回text中命中的词，去重后按第一次出现的顺序排列
+func (m *Matcher) Match(text string) []string {
+	var (
+		words []string
+		seen  = make(map[string]struct{})
+	)
+	m.scan(text, func(h Hit) bool {
+		if _, ok := seen[h.Word]; !ok {
+			seen[h.Word] = struct{}{}
+			words = append(words, h.Word)
+		}
+		return true
+	})
+	return words
+}
+
+// Mask 把命中的字符替换为mask，没有命中时返回原字符串
+func (m *Matcher) Mask(text string, mask rune) string {
+	hits := m.FindAll(text)
+	if len(hits) == 0 {
+		return text
+	}
+
+	runes := []rune(text)
+	for _, h := range hits {
+		for i := h.Start; i < h.End; i++ {
+			runes[i] = mask
+		}
+	}
+	return string(runes)
+}
+
+// scan 依次回调每个命中，fn返回false时停止
+func (m *Matcher) scan(text string, fn func(Hit) bool) {
+	if m == nil || m.size == 0 {
+		return
+	}
+
+	cur := m.root
+	var pos int
+	for _, r := range text {
+		r = unicode.ToLower(r)
+		for cur != m.root && cur.children[r] == nil {
+			cur = cur.fail
+		}
+		if next, ok := cur.children[r]; ok {
+			cur = next
+		}
+		pos++
+
+		out := cur
+		if len(out.word) == 0 {
+			out = out.output
+		}
+		for ; out != nil; out = out.output {
+			if !fn(Hit{Word: out.word, Start: pos - out.length, End: pos}) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package sensitive
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMatcherFindAll(t *testing.T) {
+	m := NewMatcher([]string{"he", "she", "his", "hers", "", "she"})
+	if m.Len() != 4 {
+		t.Fatalf("expected 4 words, got %d", m.Len())
+	}
+
+	hits := m.FindAll("ushers")
+	want := []Hit{
+		{Word: "she", Start: 1, End: 4},
+		{Word: "he", Start: 2, End: 4},
+		{Word: "hers", Start: 2, End: 6},
+	}
+	if !reflect.DeepEqual(hits, want) {
+		t.Fatalf("unexpected hits: %+v", hits)
+	}
+	if !m.Contains("nothing here") {
+		t.Fatal("expected to contain he")
+	}
+	if m.Contains("abc") {
+		t.Fatal("unexpected match")
+	}
+}
+
+func TestMatcherUnicodeAndCase(t *testing.T) {
+	m := NewMatcher([]string{"赌博", "代开发票", "VPN"})
+
+	words := m.Match("这里可以代开发票，还有赌博网站和vpn，赌博！")
+	if !reflect.DeepEqual(words, []string{"代开发票", "赌博", "VPN"}) {
+		t.Fatalf("unexpected words: %v", words)
+	}
+
+	masked := m.Mask("用Vpn去赌博", DefaultMask)
+	if masked != "用***去**" {
+		t.Fatalf("unexpected masked text: %s", masked)
+	}
+	if s := m.Mask("正常的内容", DefaultMask); s != "正常的内容" {
+		t.Fatalf("unexpected masked text: %s", s)
+	}
+}
+
+func TestNilDict(t *testing.T) {
+	var d *Dict
+	if d.Contains("赌博") || d.Match("赌博") != nil || d.Mask("赌博") != "赌博" {
+		t.Fatal("nil dict should not match")
+	}
+	d.Close()
+
+	if MustNewDict(Conf{}) != nil {
+		t.Fatal("expected nil dict without file")
+	}
+}
+
+func TestDictReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	if err := os.WriteFile(path, []byte("# 注释\n赌博\n\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := NewDict(Conf{File: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	if !d.Contains("赌博") || d.Contains("发票") {
+		t.Fatal("unexpected initial dict")
+	}
+
+	if err = os.WriteFile(path, []byte("赌博\n发票\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// 部分文件系统的修改时间精度较低，手动设置保证能检测到修改
+	later := time.Now().Add(time.Second)
+	if err = os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if !d.Contains("发票") {
+		t.Fatal("dict not reloaded")
+	}
+
+	// 文件被删除时继续使用原来的词库
+	if err = os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.Reload(); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+	if !d.Contains("发票") {
+		t.Fatal("dict should keep previous words")
+	}
+}
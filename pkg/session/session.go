@@ -0,0 +1,206 @@
+// Package session 基于redis记录用户登录的设备，token中的sid字段对应一个会话，
+// 删除会话后携带该sid的token都会被拒绝
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	// ClaimKey token中会话ID的字段名
+	ClaimKey = "sid"
+
+	prefixUserSessions = "biz#session#user#%s"
+	// 最后活跃时间的更新间隔，避免每个请求都写redis
+	touchInterval = 60
+)
+
+// 只有会话仍是读取时的值才更新，避免会话在读取后被删除又被重新写入
+// 返回0表示会话已经不存在，值已被其他请求更新时不需要再写
+var touchScript = redis.NewScript(`local current = redis.call("HGET", KEYS[1], ARGV[1])
+if not current then
+    return 0
+end
+if current == ARGV[2] then
+    redis.call("HSET", KEYS[1], ARGV[1], ARGV[3])
+    redis.call("EXPIRE", KEYS[1], ARGV[4])
+end
+return 1`)
+
+type (
+	Session struct {
+		Id         string `json:"id"`
+		Device     string `json:"device"`
+		Ip         string `json:"ip"`
+		UserAgent  string `json:"user_agent"`
+		CreateTime int64  `json:"create_time"`
+		LastSeen   int64  `json:"last_seen"`
+	}
+
+	// Store 每个用户的会话保存在一个hash中，field为会话ID
+	Store struct {
+		rds store
+		// 会话不活跃超过此时间后失效，与refresh token的有效期一致
+		// 为0时只校验会话是否存在，用于只校验access token的下游服务
+		expire int64
+		now    func() time.Time
+	}
+
+	store interface {
+		HgetCtx(ctx context.Context, key, field string) (string, error)
+		HgetallCtx(ctx context.Context, key string) (map[string]string, error)
+		HsetCtx(ctx context.Context, key, field, value string) error
+		HdelCtx(ctx context.Context, key string, fields ...string) (bool, error)
+		DelCtx(ctx context.Context, keys ...string) (int, error)
+		ExpireCtx(ctx context.Context, key string, seconds int) error
+		ScriptRunCtx(ctx context.Context, script *redis.Script, keys []string, args ...any) (any, error)
+	}
+)
+
+func NewStore(rds *redis.Redis, expire int64) *Store {
+	return &Store{
+		rds:    rds,
+		expire: expire,
+		now:    time.Now,
+	}
+}
+
+// Create 创建会话，超过maxSessions时删除最久没有活跃的会话，返回被删除的会话ID
+// maxSessions为0表示不限制
+func (s *Store) Create(ctx context.Context, userId string, session *Session, maxSessions int) ([]string, error) {
+	now := s.now().Unix()
+	session.CreateTime = now
+	session.LastSeen = now
+	if err := s.save(ctx, userId, session); err != nil {
+		return nil, err
+	}
+
+	if maxSessions <= 0 {
+		return nil, nil
+	}
+	sessions, err := s.List(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	var evicted []string
+	for i := maxSessions; i < len(sessions); i++ {
+		if sessions[i].Id == session.Id {
+			continue
+		}
+		evicted = append(evicted, sessions[i].Id)
+	}
+	if len(evicted) > 0 {
+		if _, err = s.rds.HdelCtx(ctx, userSessionsKey(userId), evicted...); err != nil {
+			return nil, err
+		}
+	}
+
+	return evicted, nil
+}
+
+// List 按最后活跃时间倒序返回用户的会话，同时清理已经过期的会话
+func (s *Store) List(ctx context.Context, userId string) ([]*Session, error) {
+	key := userSessionsKey(userId)
+	vals, err := s.rds.HgetallCtx(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().Unix()
+	sessions := make([]*Session, 0, len(vals))
+	var expired []string
+	for id, val := range vals {
+		var session Session
+		if err = json.Unmarshal([]byte(val), &session); err != nil || s.isExpired(&session, now) {
+			expired = append(expired, id)
+			continue
+		}
+		sessions = append(sessions, &session)
+	}
+	if len(expired) > 0 {
+		if _, err = s.rds.HdelCtx(ctx, key, expired...); err != nil {
+			logx.WithContext(ctx).Errorf("HdelCtx key: %s error: %v", key, err)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen > sessions[j].LastSeen
+	})
+
+	return sessions, nil
+}
+
+// Touch 判断会话是否存在，存在时更新最后活跃时间
+func (s *Store) Touch(ctx context.Context, userId, sessionId string) (bool, error) {
+	key := userSessionsKey(userId)
+	val, err := s.rds.HgetCtx(ctx, key, sessionId)
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var session Session
+	if err = json.Unmarshal([]byte(val), &session); err != nil {
+		return false, nil
+	}
+	now := s.now().Unix()
+	if s.isExpired(&session, now) {
+		return false, nil
+	}
+	if s.expire == 0 || now-session.LastSeen < touchInterval {
+		return true, nil
+	}
+
+	session.LastSeen = now
+	newVal, err := json.Marshal(&session)
+	if err != nil {
+		return false, err
+	}
+	ret, err := s.rds.ScriptRunCtx(ctx, touchScript, []string{key}, sessionId, val, string(newVal), strconv.FormatInt(s.expire, 10))
+	if err != nil {
+		return false, err
+	}
+
+	return ret == int64(1), nil
+}
+
+// Delete 删除会话，会话不存在时返回false
+func (s *Store) Delete(ctx context.Context, userId, sessionId string) (bool, error) {
+	return s.rds.HdelCtx(ctx, userSessionsKey(userId), sessionId)
+}
+
+// DeleteAll 删除用户的所有会话
+func (s *Store) DeleteAll(ctx context.Context, userId string) error {
+	_, err := s.rds.DelCtx(ctx, userSessionsKey(userId))
+	return err
+}
+
+func (s *Store) save(ctx context.Context, userId string, session *Session) error {
+	val, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	key := userSessionsKey(userId)
+	if err = s.rds.HsetCtx(ctx, key, session.Id, string(val)); err != nil {
+		return err
+	}
+	return s.rds.ExpireCtx(ctx, key, int(s.expire))
+}
+
+func (s *Store) isExpired(session *Session, now int64) bool {
+	return s.expire > 0 && session.LastSeen+s.expire < now
+}
+
+func userSessionsKey(userId string) string {
+	return fmt.Sprintf(prefixUserSessions, userId)
+}
@@ -0,0 +1,254 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// fakeStore 在内存中实现hash命令，ScriptRunCtx按touchScript的语义执行
+type fakeStore struct {
+	hashes  map[string]map[string]string
+	expires map[string]int
+	// 在脚本执行前调用，用于模拟读取和写入之间的并发修改
+	beforeScript func()
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		hashes:  make(map[string]map[string]string),
+		expires: make(map[string]int),
+	}
+}
+
+func (s *fakeStore) HgetCtx(_ context.Context, key, field string) (string, error) {
+	val, ok := s.hashes[key][field]
+	if !ok {
+		return "", redis.Nil
+	}
+	return val, nil
+}
+
+func (s *fakeStore) HgetallCtx(_ context.Context, key string) (map[string]string, error) {
+	ret := make(map[string]string, len(s.hashes[key]))
+	for k, v := range s.hashes[key] {
+		ret[k] = v
+	}
+	return ret, nil
+}
+
+func (s *fakeStore) HsetCtx(_ context.Context, key, field, value string) error {
+	if s.hashes[key] == nil {
+		s.hashes[key] = make(map[string]string)
+	}
+	s.hashes[key][field] = value
+	return nil
+}
+
+func (s *fakeStore) HdelCtx(_ context.Context, key string, fields ...string) (bool, error) {
+	var deleted bool
+	for _, field := range fields {
+		if _, ok := s.hashes[key][field]; ok {
+			delete(s.hashes[key], field)
+			deleted = true
+		}
+	}
+	return deleted, nil
+}
+
+func (s *fakeStore) DelCtx(_ context.Context, keys ...string) (int, error) {
+	var deleted int
+	for _, key := range keys {
+		if _, ok := s.hashes[key]; ok {
+			delete(s.hashes, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (s *fakeStore) ExpireCtx(_ context.Context, key string, seconds int) error {
+	s.expires[key] = seconds
+	return nil
+}
+
+func (s *fakeStore) ScriptRunCtx(ctx context.Context, _ *redis.Script, keys []string, args ...any) (any, error) {
+	if s.beforeScript != nil {
+		s.beforeScript()
+	}
+
+	field, old, val := args[0].(string), args[1].(string), args[2].(string)
+	current, ok := s.hashes[keys[0]][field]
+	if !ok {
+		return int64(0), nil
+	}
+	if current == old {
+		s.hashes[keys[0]][field] = val
+		s.expires[keys[0]], _ = strconv.Atoi(args[3].(string))
+	}
+	return int64(1), nil
+}
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func newTestStore(expire int64) (*Store, *fakeStore, *clock) {
+	rds := newFakeStore()
+	c := &clock{now: time.Unix(1700000000, 0)}
+	return &Store{rds: rds, expire: expire, now: c.Now}, rds, c
+}
+
+func TestCreateEvict(t *testing.T) {
+	s, rds, c := newTestStore(3600)
+	ctx := context.Background()
+
+	for i := 1; i <= 3; i++ {
+		evicted, err := s.Create(ctx, "1", &Session{Id: fmt.Sprint(i)}, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i < 3 && len(evicted) > 0 {
+			t.Errorf("session %d evicted %v", i, evicted)
+		}
+		if i == 3 && (len(evicted) != 1 || evicted[0] != "1") {
+			t.Errorf("evicted = %v, want [1]", evicted)
+		}
+		c.now = c.now.Add(time.Second)
+	}
+
+	sessions, err := s.List(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 2 || sessions[0].Id != "3" || sessions[1].Id != "2" {
+		t.Errorf("sessions = %+v", sessions)
+	}
+	if rds.expires[userSessionsKey("1")] != 3600 {
+		t.Errorf("expire = %d", rds.expires[userSessionsKey("1")])
+	}
+}
+
+func TestTouch(t *testing.T) {
+	s, _, c := newTestStore(3600)
+	ctx := context.Background()
+	if _, err := s.Create(ctx, "1", &Session{Id: "a"}, 0); err != nil {
+		t.Fatal(err)
+	}
+	created := c.now.Unix()
+
+	// 更新间隔内不写redis
+	c.now = c.now.Add(touchInterval / 2 * time.Second)
+	if ok, err := s.Touch(ctx, "1", "a"); err != nil || !ok {
+		t.Fatalf("Touch = %v, %v", ok, err)
+	}
+	sessions, _ := s.List(ctx, "1")
+	if sessions[0].LastSeen != created {
+		t.Errorf("last seen = %d, want %d", sessions[0].LastSeen, created)
+	}
+
+	c.now = c.now.Add(touchInterval * time.Second)
+	if ok, err := s.Touch(ctx, "1", "a"); err != nil || !ok {
+		t.Fatalf("Touch = %v, %v", ok, err)
+	}
+	sessions, _ = s.List(ctx, "1")
+	if sessions[0].LastSeen != c.now.Unix() {
+		t.Errorf("last seen = %d, want %d", sessions[0].LastSeen, c.now.Unix())
+	}
+
+	if ok, err := s.Touch(ctx, "1", "b"); err != nil || ok {
+		t.Errorf("Touch unknown session = %v, %v", ok, err)
+	}
+}
+
+// 读取会话后会话被删除，Touch不能把会话重新写回去
+func TestTouchDeleted(t *testing.T) {
+	s, rds, c := newTestStore(3600)
+	ctx := context.Background()
+	if _, err := s.Create(ctx, "1", &Session{Id: "a"}, 0); err != nil {
+		t.Fatal(err)
+	}
+	c.now = c.now.Add(2 * touchInterval * time.Second)
+	rds.beforeScript = func() {
+		s.Delete(ctx, "1", "a")
+	}
+
+	ok, err := s.Touch(ctx, "1", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Error("Touch should report deleted session")
+	}
+	if _, exists := rds.hashes[userSessionsKey("1")]["a"]; exists {
+		t.Error("deleted session written back")
+	}
+}
+
+// 读取会话后其他请求已经更新了最后活跃时间，会话仍然有效
+func TestTouchConcurrent(t *testing.T) {
+	s, rds, c := newTestStore(3600)
+	ctx := context.Background()
+	if _, err := s.Create(ctx, "1", &Session{Id: "a"}, 0); err != nil {
+		t.Fatal(err)
+	}
+	c.now = c.now.Add(2 * touchInterval * time.Second)
+	other := `{"id":"a","last_seen":1}`
+	rds.beforeScript = func() {
+		rds.hashes[userSessionsKey("1")]["a"] = other
+	}
+
+	ok, err := s.Touch(ctx, "1", "a")
+	if err != nil || !ok {
+		t.Fatalf("Touch = %v, %v", ok, err)
+	}
+	if val := rds.hashes[userSessionsKey("1")]["a"]; val != other {
+		t.Errorf("session overwritten: %s", val)
+	}
+}
+
+func TestExpired(t *testing.T) {
+	s, rds, c := newTestStore(3600)
+	ctx := context.Background()
+	if _, err := s.Create(ctx, "1", &Session{Id: "a"}, 0); err != nil {
+		t.Fatal(err)
+	}
+	c.now = c.now.Add(3601 * time.Second)
+
+	if ok, err := s.Touch(ctx, "1", "a"); err != nil || ok {
+		t.Errorf("Touch expired session = %v, %v", ok, err)
+	}
+	sessions, err := s.List(ctx, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 0 {
+		t.Errorf("sessions = %+v", sessions)
+	}
+	if _, exists := rds.hashes[userSessionsKey("1")]["a"]; exists {
+		t.Error("expired session not removed")
+	}
+}
+
+// expire为0时只校验会话是否存在
+func TestTouchWithoutExpire(t *testing.T) {
+	s, rds, c := newTestStore(0)
+	ctx := context.Background()
+	rds.HsetCtx(ctx, userSessionsKey("1"), "a", fmt.Sprintf(`{"id":"a","last_seen":%d}`, c.now.Unix()))
+	c.now = c.now.Add(24 * time.Hour)
+	rds.beforeScript = func() {
+		t.Error("session should not be written")
+	}
+
+	if ok, err := s.Touch(ctx, "1", "a"); err != nil || !ok {
+		t.Errorf("Touch = %v, %v", ok, err)
+	}
+}
@@ -0,0 +1,81 @@
+package signature
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/utils"
+)
+
+type (
+	// Signer 为请求添加签名头，供内部工具调用签名接口
+	Signer struct {
+		appKey    string
+		appSecret string
+	}
+
+	// Transport 自动为每个请求签名的http.RoundTripper
+	Transport struct {
+		Signer *Signer
+		Base   http.RoundTripper // 为空时使用http.DefaultTransport
+	}
+)
+
+func NewSigner(appKey, appSecret string) *Signer {
+	return &Signer{
+		appKey:    appKey,
+		appSecret: appSecret,
+	}
+}
+
+// NewClient 返回一个自动签名的http.Client
+func NewClient(appKey, appSecret string, timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: &Transport{Signer: NewSigner(appKey, appSecret)},
+		Timeout:   timeout,
+	}
+}
+
+// Sign 读取请求体计算摘要并设置签名头，请求体会被替换为可重复读取的副本
+func (s *Signer) Sign(r *http.Request) error {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	nonce := utils.NewUuid()
+	digest := BodyDigest(body)
+	r.Header.Set(HeaderAppKey, s.appKey)
+	r.Header.Set(HeaderTimestamp, timestamp)
+	r.Header.Set(HeaderNonce, nonce)
+	r.Header.Set(HeaderDigest, digest)
+	r.Header.Set(HeaderSignature, Sign(s.appSecret, StringToSign(r.Method, r.URL, timestamp, nonce, digest)))
+	return nil
+}
+
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	// RoundTripper不能修改原请求
+	r = r.Clone(r.Context())
+	if err := t.Signer.Sign(r); err != nil {
+		return nil, err
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(r)
+}
@@ -0,0 +1,116 @@
+package signature
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryNonceStore struct {
+	mu     sync.Mutex
+	nonces map[string]bool
+}
+
+func (s *memoryNonceStore) Remember(_ context.Context, appKey, nonce string, _ int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := appKey + "#" + nonce
+	if s.nonces[key] {
+		return false, nil
+	}
+	s.nonces[key] = true
+	return true, nil
+}
+
+func newTestVerifier() *Verifier {
+	return NewVerifier(Conf{
+		Expiry: 300,
+		Keys:   []Key{{AppKey: "tool", AppSecret: "secret"}},
+	}, &memoryNonceStore{nonces: make(map[string]bool)})
+}
+
+func TestClientAndVerifier(t *testing.T) {
+	verifier := newTestVerifier()
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := verifier.Verify(r); err != nil {
+			t.Errorf("verify error: %v", err)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"title":"hello"}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+		calls++
+	}))
+	defer srv.Close()
+
+	client := NewClient("tool", "secret", time.Second)
+	resp, err := client.Post(srv.URL+"/v1/article/publish?b=2&a=1", "application/json", strings.NewReader(`{"title":"hello"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 1 {
+		t.Fatalf("unexpected status: %d calls: %d", resp.StatusCode, calls)
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	newRequest := func(body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/v1/user/info?x=1", strings.NewReader(body))
+		if err := NewSigner("tool", "secret").Sign(r); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *http.Request)
+		err    error
+	}{
+		{"missing header", func(r *http.Request) { r.Header.Del(HeaderSignature) }, ErrMissingHeader},
+		{"unknown app key", func(r *http.Request) { r.Header.Set(HeaderAppKey, "other") }, ErrUnknownAppKey},
+		{"short nonce", func(r *http.Request) { r.Header.Set(HeaderNonce, "abc") }, ErrInvalidNonce},
+		{"expired", func(r *http.Request) {
+			r.Header.Set(HeaderTimestamp, strconv.FormatInt(time.Now().Unix()-301, 10))
+		}, ErrTimestampExpired},
+		{"tampered body", func(r *http.Request) { r.Body = io.NopCloser(strings.NewReader("tampered")) }, ErrDigestMismatch},
+		{"tampered query", func(r *http.Request) { r.URL.RawQuery = "x=2" }, ErrInvalidSignature},
+		{"tampered method", func(r *http.Request) { r.Method = http.MethodPut }, ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRequest("body")
+			tt.modify(r)
+			if err := newTestVerifier().Verify(r); err != tt.err {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestVerifyReplay(t *testing.T) {
+	verifier := newTestVerifier()
+	r := httptest.NewRequest(http.MethodGet, "/v1/user/info", nil)
+	if err := NewSigner("tool", "secret").Sign(r); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifier.Verify(r); err != nil {
+		t.Fatal(err)
+	}
+
+	replay := httptest.NewRequest(http.MethodGet, "/v1/user/info", nil)
+	replay.Header = r.Header.Clone()
+	if err := verifier.Verify(replay); err != ErrNonceReplayed {
+		t.Fatalf("expected ErrNonceReplayed, got %v", err)
+	}
+}
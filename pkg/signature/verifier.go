@@ -0,0 +1,119 @@
+package signature
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	prefixNonce    = "biz#signature#nonce#%s#%s"
+	minNonceLength = 8
+	maxNonceLength = 64
+)
+
+type (
+	// NonceStore 记录已经使用过的nonce，用于防重放
+	NonceStore interface {
+		// Remember 记录nonce，已经存在时返回false
+		Remember(ctx context.Context, appKey, nonce string, ttl int) (bool, error)
+	}
+
+	RedisNonceStore struct {
+		rds *redis.Redis
+	}
+
+	// Verifier 校验请求签名
+	Verifier struct {
+		expiry  int64
+		secrets map[string]string
+		nonces  NonceStore
+	}
+)
+
+func NewRedisNonceStore(rds *redis.Redis) *RedisNonceStore {
+	return &RedisNonceStore{rds: rds}
+}
+
+func (s *RedisNonceStore) Remember(ctx context.Context, appKey, nonce string, ttl int) (bool, error) {
+	return s.rds.SetnxExCtx(ctx, fmt.Sprintf(prefixNonce, appKey, nonce), "1", ttl)
+}
+
+func NewVerifier(c Conf, nonces NonceStore) *Verifier {
+	secrets := make(map[string]string, len(c.Keys))
+	for _, key := range c.Keys {
+		secrets[key.AppKey] = key.AppSecret
+	}
+	return &Verifier{
+		expiry:  c.Expiry,
+		secrets: secrets,
+		nonces:  nonces,
+	}
+}
+
+// Verify 校验签名，校验通过后请求体可以被再次读取
+func (v *Verifier) Verify(r *http.Request) error {
+	// 1、检查请求头
+	appKey := r.Header.Get(HeaderAppKey)
+	timestamp := r.Header.Get(HeaderTimestamp)
+	nonce := r.Header.Get(HeaderNonce)
+	digest := r.Header.Get(HeaderDigest)
+	sign := r.Header.Get(HeaderSignature)
+	if len(appKey) == 0 || len(timestamp) == 0 || len(nonce) == 0 || len(digest) == 0 || len(sign) == 0 {
+		return ErrMissingHeader
+	}
+	secret, ok := v.secrets[appKey]
+	if !ok {
+		return ErrUnknownAppKey
+	}
+	if len(nonce) < minNonceLength || len(nonce) > maxNonceLength {
+		return ErrInvalidNonce
+	}
+
+	// 2、时间戳必须在允许的偏差内
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrTimestampExpired
+	}
+	if delta := time.Now().Unix() - ts; delta > v.expiry || delta < -v.expiry {
+		return ErrTimestampExpired
+	}
+
+	// 3、校验请求体摘要，并恢复请求体
+	var body []byte
+	if r.Body != nil {
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if !hmac.Equal([]byte(BodyDigest(body)), []byte(digest)) {
+		return ErrDigestMismatch
+	}
+
+	// 4、校验签名
+	expected := Sign(secret, StringToSign(r.Method, r.URL, timestamp, nonce, digest))
+	if !hmac.Equal([]byte(expected), []byte(sign)) {
+		return ErrInvalidSignature
+	}
+
+	// 5、签名通过后再记录nonce，避免伪造的请求占用nonce
+	ok, err = v.nonces.Remember(r.Context(), appKey, nonce, int(2*v.expiry))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNonceReplayed
+	}
+
+	return nil
+}
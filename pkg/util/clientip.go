@@ -0,0 +1,94 @@
+package util
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ClientInfo 请求的客户端信息，由中间件放到context中，业务逻辑不需要直接读取http.Request
+type ClientInfo struct {
+	Device    string
+	UserAgent string
+	Ip        string
+}
+
+type clientInfoKey struct{}
+
+func WithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+// ClientInfoFromContext 没有经过中间件时返回空值
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return info
+}
+
+// ParseCIDRs 解析可信代理的网段，单个IP按/32或/128处理
+func ParseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if !strings.Contains(cidr, "/") {
+			if ip := net.ParseIP(cidr); ip != nil && ip.To4() != nil {
+				cidr += "/32"
+			} else {
+				cidr += "/128"
+			}
+		}
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, n)
+	}
+
+	return nets, nil
+}
+
+// ClientIp 获取客户端IP
+// 只有对端地址是可信代理时才读取X-Forwarded-For，从右往左跳过可信代理追加的地址，
+// 第一个不可信的地址就是客户端IP；客户端自己填写的X-Forwarded-For在最左边，不会被采信
+func ClientIp(r *http.Request, trustedProxies []*net.IPNet) string {
+	ip := remoteIp(r.RemoteAddr)
+	if !isTrusted(ip, trustedProxies) {
+		return ip
+	}
+
+	forwarded := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if len(addr) == 0 {
+			continue
+		}
+		ip = addr
+		if !isTrusted(ip, trustedProxies) {
+			break
+		}
+	}
+
+	return ip
+}
+
+func remoteIp(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+
+	return addr
+}
+
+func isTrusted(ip string, trustedProxies []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, n := range trustedProxies {
+		if n.Contains(parsed) {
+			return true
+		}
+	}
+
+	return false
+}
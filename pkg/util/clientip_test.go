@@ -0,0 +1,44 @@
+package util
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestClientIp(t *testing.T) {
+	proxies, err := ParseCIDRs([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{"direct", "1.2.3.4:5678", "", "1.2.3.4"},
+		{"untrusted peer ignores header", "1.2.3.4:5678", "8.8.8.8", "1.2.3.4"},
+		{"trusted proxy", "10.0.0.1:80", "1.2.3.4", "1.2.3.4"},
+		{"spoofed left-most entry", "10.0.0.1:80", "8.8.8.8, 1.2.3.4", "1.2.3.4"},
+		{"proxy chain", "10.0.0.1:80", "8.8.8.8, 1.2.3.4, 192.168.1.1", "1.2.3.4"},
+		{"all trusted", "10.0.0.1:80", "10.0.0.2", "10.0.0.2"},
+		{"trusted proxy without header", "10.0.0.1:80", "", "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodPost, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if len(tt.forwarded) > 0 {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			if got := ClientIp(r, proxies); got != tt.want {
+				t.Fatalf("ClientIp() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err = ParseCIDRs([]string{"not-an-ip"}); err == nil {
+		t.Fatal("expected error for invalid cidr")
+	}
+}